@@ -0,0 +1,293 @@
+// Package audit checks distributions against a database of security
+// advisories in the CPANSA format (the format of CPAN::Audit and of the
+// cpan-security-advisory feeds), in JSON or YAML.
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/dolmen-go/CPAN"
+	"github.com/dolmen-go/CPAN/carton"
+)
+
+// DB is an advisory database.
+type DB struct {
+	// Dists are the advisories by distribution name, such as "Foo-Bar".
+	Dists map[string]*Dist `json:"dists" yaml:"dists"`
+
+	// modules maps the main module of each distribution to its name
+	modules map[string]string
+}
+
+// Dist is the entry of a distribution in the database.
+type Dist struct {
+	MainModule string      `json:"main_module,omitempty" yaml:"main_module"`
+	Advisories []*Advisory `json:"advisories" yaml:"advisories"`
+}
+
+// Advisory is a security advisory on a distribution.
+type Advisory struct {
+	ID           string `json:"id" yaml:"id"`
+	Distribution string `json:"distribution" yaml:"distribution"`
+	// AffectedVersions are the ranges of vulnerable versions, such as
+	// ">=1.0,<1.5". A bare version matches only that version.
+	AffectedVersions Ranges   `json:"affected_versions" yaml:"affected_versions"`
+	FixedVersions    Ranges   `json:"fixed_versions,omitempty" yaml:"fixed_versions"`
+	CVEs             []string `json:"cves,omitempty" yaml:"cves"`
+	Description      string   `json:"description,omitempty" yaml:"description"`
+	Severity         string   `json:"severity,omitempty" yaml:"severity"`
+	Reported         string   `json:"reported,omitempty" yaml:"reported"`
+	References       []string `json:"references,omitempty" yaml:"references"`
+
+	affected, fixed []CPAN.VersionRange
+}
+
+// Ranges is a list of version ranges, of which any may match. It is
+// decoded from a single string or from a list.
+type Ranges []string
+
+func (r *Ranges) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*r = Ranges{s}
+		return nil
+	}
+	return json.Unmarshal(b, (*[]string)(r))
+}
+
+func (r *Ranges) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	// Decoding to strings keeps the text of versions such as 1.10
+	var s string
+	if err := unmarshal(&s); err == nil {
+		*r = Ranges{s}
+		return nil
+	}
+	return unmarshal((*[]string)(r))
+}
+
+// parse parses the ranges with the semantics of CPANSA: a bare version, or
+// "=", means "==".
+func (r Ranges) parse() ([]CPAN.VersionRange, error) {
+	list := make([]CPAN.VersionRange, 0, len(r))
+	for _, s := range r {
+		parts := strings.Split(s, ",")
+		for i, part := range parts {
+			part = strings.TrimSpace(part)
+			switch {
+			case part == "":
+				return nil, fmt.Errorf("invalid version range %q", s)
+			case strings.HasPrefix(part, "=") && !strings.HasPrefix(part, "=="):
+				part = "=" + part
+			case strings.IndexAny(part[:1], "<>=!") < 0:
+				part = "==" + part
+			}
+			parts[i] = part
+		}
+		rng, err := CPAN.ParseVersionRange(strings.Join(parts, ","))
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, rng)
+	}
+	return list, nil
+}
+
+// Affects reports whether version of the distribution is vulnerable.
+// Unknown or invalid versions are considered vulnerable.
+func (a *Advisory) Affects(version string) bool {
+	v, err := CPAN.ParseVersion(version)
+	if err != nil || version == "" || version == "undef" {
+		return true
+	}
+	for _, r := range a.affected {
+		if r.Accepts(v) {
+			return true
+		}
+	}
+	if len(a.affected) > 0 || len(a.fixed) == 0 {
+		return false
+	}
+	// Only the fixed versions are given
+	for _, r := range a.fixed {
+		if r.Accepts(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// ReadDB reads a database in JSON or YAML.
+func ReadDB(r io.Reader) (*DB, error) {
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var db DB
+	if trimmed := bytes.TrimSpace(buf); len(trimmed) > 0 && trimmed[0] == '{' {
+		err = json.Unmarshal(buf, &db)
+	} else {
+		err = yaml.Unmarshal(buf, &db)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = db.init(); err != nil {
+		return nil, err
+	}
+	return &db, nil
+}
+
+// LoadDB reads the database in file.
+func LoadDB(file string) (*DB, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	db, err := ReadDB(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", file, err)
+	}
+	return db, nil
+}
+
+func (db *DB) init() error {
+	db.modules = make(map[string]string, len(db.Dists))
+	for name, d := range db.Dists {
+		if d == nil {
+			continue
+		}
+		if d.MainModule != "" {
+			db.modules[d.MainModule] = name
+		}
+		for _, a := range d.Advisories {
+			var err error
+			if a.affected, err = a.AffectedVersions.parse(); err != nil {
+				return fmt.Errorf("%s: affected_versions: %s", a.ID, err)
+			}
+			if a.fixed, err = a.FixedVersions.parse(); err != nil {
+				return fmt.Errorf("%s: fixed_versions: %s", a.ID, err)
+			}
+			if a.Distribution == "" {
+				a.Distribution = name
+			}
+		}
+	}
+	return nil
+}
+
+// DistOfModule returns the name of the distribution whose main module is
+// module, or the empty string.
+func (db *DB) DistOfModule(module string) string {
+	return db.modules[module]
+}
+
+// Advisories returns the advisories that affect version of the
+// distribution dist (such as "Foo-Bar").
+func (db *DB) Advisories(dist, version string) []*Advisory {
+	d := db.Dists[dist]
+	if d == nil {
+		return nil
+	}
+	var list []*Advisory
+	for _, a := range d.Advisories {
+		if a.Affects(version) {
+			list = append(list, a)
+		}
+	}
+	return list
+}
+
+// Finding is an advisory that affects a distribution.
+type Finding struct {
+	Dist    string `json:"dist"`
+	Version string `json:"version"`
+	// Source is what the distribution was found from: the path of the
+	// release, or the module of an installed list.
+	Source   string    `json:"source"`
+	Advisory *Advisory `json:"advisory"`
+}
+
+func (f *Finding) String() string {
+	s := fmt.Sprintf("%s %s: %s", f.Dist, f.Version, f.Advisory.ID)
+	if len(f.Advisory.CVEs) > 0 {
+		s += " (" + strings.Join(f.Advisory.CVEs, ", ") + ")"
+	}
+	if f.Advisory.Severity != "" {
+		s += " [" + f.Advisory.Severity + "]"
+	}
+	return s
+}
+
+func (db *DB) check(findings []*Finding, dist, version, source string) []*Finding {
+	for _, a := range db.Advisories(dist, version) {
+		findings = append(findings, &Finding{Dist: dist, Version: version, Source: source, Advisory: a})
+	}
+	return findings
+}
+
+func sortFindings(findings []*Finding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Dist != findings[j].Dist {
+			return findings[i].Dist < findings[j].Dist
+		}
+		return findings[i].Advisory.ID < findings[j].Advisory.ID
+	})
+}
+
+// CheckDists checks releases given by their path relative to authors/id,
+// such as "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz".
+func (db *DB) CheckDists(paths ...string) []*Finding {
+	var findings []*Finding
+	for _, p := range paths {
+		name, version := (&CPAN.PackagesIndexEntry{Path: p}).Dist()
+		findings = db.check(findings, name, version, p)
+	}
+	sortFindings(findings)
+	return findings
+}
+
+// CheckSnapshot checks the distributions of a cpanfile.snapshot.
+func (db *DB) CheckSnapshot(s *carton.Snapshot) []*Finding {
+	paths := make([]string, len(s.Dists))
+	for i, d := range s.Dists {
+		paths[i] = d.Pathname
+	}
+	return db.CheckDists(paths...)
+}
+
+// CheckIndex checks the distributions of entries of 02packages.
+func (db *DB) CheckIndex(entries []*CPAN.PackagesIndexEntry) []*Finding {
+	seen := make(map[string]bool)
+	var paths []string
+	for _, e := range entries {
+		if !seen[e.Path] {
+			seen[e.Path] = true
+			paths = append(paths, e.Path)
+		}
+	}
+	return db.CheckDists(paths...)
+}
+
+// CheckModules checks installed modules, given with their version. The
+// version of the main module of a distribution is taken as the version of
+// the distribution; other modules are ignored.
+func (db *DB) CheckModules(modules map[string]string) []*Finding {
+	var findings []*Finding
+	for module, version := range modules {
+		if dist := db.modules[module]; dist != "" {
+			findings = db.check(findings, dist, version, module)
+		}
+	}
+	sortFindings(findings)
+	return findings
+}
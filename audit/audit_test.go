@@ -0,0 +1,101 @@
+package audit
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dolmen-go/CPAN"
+	"github.com/dolmen-go/CPAN/carton"
+)
+
+func ids(findings []*Finding) []string {
+	list := make([]string, len(findings))
+	for i, f := range findings {
+		list[i] = f.Dist + " " + f.Version + " " + f.Advisory.ID
+	}
+	return list
+}
+
+func TestAudit(t *testing.T) {
+	for _, file := range []string{"testdata/cpansa.json", "testdata/cpansa.yml"} {
+		db, err := LoadDB(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for version, expected := range map[string]int{
+			"1.01":  1,
+			"1.02":  0,
+			"1.05":  1,
+			"1.050": 1,
+			"1.09":  0,
+			"1.10":  1,
+			"1.11":  1,
+			"1.12":  0,
+			"1.9":   0, // 1.9 < 1.10 in Perl
+			"undef": 2,
+		} {
+			if got := len(db.Advisories("Foo-Bar", version)); got != expected {
+				t.Errorf("%s: Foo-Bar %s: got %d advisories, expected %d", file, version, got, expected)
+			}
+		}
+
+		findings := db.CheckDists(
+			"D/DO/DOLMEN/Foo-Bar-1.01.tar.gz",
+			"O/OT/OTHER/Baz-1.5.tar.gz",
+			"O/OT/OTHER/Safe-1.0.tar.gz",
+		)
+		expected := []string{
+			"Baz 1.5 CPANSA-Baz-2022-01",
+			"Foo-Bar 1.01 CPANSA-Foo-Bar-2020-01",
+		}
+		if !reflect.DeepEqual(ids(findings), expected) {
+			t.Errorf("%s: CheckDists: got %q", file, ids(findings))
+		}
+		if s := findings[1].String(); s != "Foo-Bar 1.01: CPANSA-Foo-Bar-2020-01 (CVE-2020-0001) [high]" {
+			t.Errorf("got %q", s)
+		}
+
+		findings = db.CheckIndex([]*CPAN.PackagesIndexEntry{
+			{Package: "Baz", Version: "2.01", Path: "O/OT/OTHER/Baz-2.01.tar.gz"},
+			{Package: "Foo::Bar", Version: "1.11", Path: "D/DO/DOLMEN/Foo-Bar-1.11.tar.gz"},
+			{Package: "Foo::Bar::Util", Version: "1.11", Path: "D/DO/DOLMEN/Foo-Bar-1.11.tar.gz"},
+		})
+		if !reflect.DeepEqual(ids(findings), []string{"Foo-Bar 1.11 CPANSA-Foo-Bar-2021-01"}) {
+			t.Errorf("%s: CheckIndex: got %q", file, ids(findings))
+		}
+
+		snap, err := carton.ReadSnapshot(strings.NewReader(carton.SnapshotHeader + `
+DISTRIBUTIONS
+  Foo-Bar-1.05
+    pathname: D/DO/DOLMEN/Foo-Bar-1.05.tar.gz
+    provides:
+      Foo::Bar 1.05
+    requirements:
+`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		findings = db.CheckSnapshot(snap)
+		if !reflect.DeepEqual(ids(findings), []string{"Foo-Bar 1.05 CPANSA-Foo-Bar-2021-01"}) {
+			t.Errorf("%s: CheckSnapshot: got %q", file, ids(findings))
+		}
+
+		findings = db.CheckModules(map[string]string{
+			"Foo::Bar":       "1.12",
+			"Foo::Bar::Util": "1.00",
+			"Baz":            "1.9",
+		})
+		if !reflect.DeepEqual(ids(findings), []string{"Baz 1.9 CPANSA-Baz-2022-01"}) {
+			t.Errorf("%s: CheckModules: got %q", file, ids(findings))
+		}
+	}
+}
+
+func TestInvalidRange(t *testing.T) {
+	_, err := ReadDB(strings.NewReader(`{"dists":{"X":{"advisories":[{"id":"CPANSA-X-1","affected_versions":"<"}]}}}`))
+	if err == nil || !strings.Contains(err.Error(), "CPANSA-X-1") {
+		t.Errorf("got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+//go:build go1.18
+// +build go1.18
+
+package carton
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+// Run with:
+//
+//	go test -run XXX -fuzz FuzzReadSnapshot
+
+func FuzzReadSnapshot(f *testing.F) {
+	src, err := ioutil.ReadFile("testdata/cpanfile.snapshot")
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(src)
+	f.Add([]byte(SnapshotHeader + "\nDISTRIBUTIONS\n  Foo-1.0\n    pathname: F/FO/FOO/Foo-1.0.tar.gz\n    provides:\n      Foo\n    requirements:\n      Bar 0\n"))
+	f.Add([]byte(SnapshotHeader + "\r\n  Foo\r\n    pathname:\r\n"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		snap, err := ReadSnapshot(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+		names := make(map[string]bool, len(snap.Dists))
+		for _, d := range snap.Dists {
+			if names[d.Name] {
+				// The order of duplicates is not kept by WriteSnapshot
+				return
+			}
+			names[d.Name] = true
+		}
+
+		// The output of WriteSnapshot is read back, and written the same
+		var out bytes.Buffer
+		if err := WriteSnapshot(&out, snap); err != nil {
+			t.Fatal(err)
+		}
+		snap2, err := ReadSnapshot(bytes.NewReader(out.Bytes()))
+		if err != nil {
+			t.Fatalf("%s\n%s", err, out.Bytes())
+		}
+		var out2 bytes.Buffer
+		if err := WriteSnapshot(&out2, snap2); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out.Bytes(), out2.Bytes()) {
+			t.Fatalf("got:\n%s\nexpected:\n%s", out2.Bytes(), out.Bytes())
+		}
+	})
+}
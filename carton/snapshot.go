@@ -0,0 +1,300 @@
+// Package carton reads and writes cpanfile.snapshot, the lock file of
+// Carton.
+package carton
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/dolmen-go/CPAN"
+	"github.com/dolmen-go/CPAN/resolver"
+)
+
+// SnapshotHeader is the first line of the supported format.
+const SnapshotHeader = "# carton snapshot format: version 1.0"
+
+// Snapshot is the content of cpanfile.snapshot.
+type Snapshot struct {
+	// Dists are sorted by name.
+	Dists []*Dist
+}
+
+// Dist is a distribution locked in a snapshot.
+type Dist struct {
+	// Name is the name of the release, such as "Class-Tiny-1.006".
+	Name string
+	// Pathname is the path relative to authors/id, such as
+	// "D/DA/DAGOLDEN/Class-Tiny-1.006.tar.gz".
+	Pathname string
+	// Provides maps packages to versions ("undef" if no version).
+	Provides map[string]string
+	// Requirements maps modules to the required version.
+	Requirements CPAN.Requirements
+}
+
+// ParseError is a syntax error in cpanfile.snapshot.
+type ParseError struct {
+	Line int
+	Msg  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("cpanfile.snapshot:%d: %s", e.Line, e.Msg)
+}
+
+// ReadSnapshot reads cpanfile.snapshot.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	s := bufio.NewScanner(r)
+	lineNum := 0
+	errorf := func(format string, args ...interface{}) error {
+		return &ParseError{Line: lineNum, Msg: fmt.Sprintf(format, args...)}
+	}
+
+	var (
+		snap    Snapshot
+		dist    *Dist
+		section string
+	)
+	for s.Scan() {
+		lineNum++
+		line := strings.TrimRight(s.Text(), " \t\r")
+		if lineNum == 1 {
+			if line != SnapshotHeader {
+				return nil, errorf("unsupported format: %q", line)
+			}
+			continue
+		}
+		if line == "" {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		line = line[indent:]
+		switch indent {
+		case 0:
+			if line != "DISTRIBUTIONS" {
+				return nil, errorf("unexpected %q", line)
+			}
+		case 2:
+			dist = &Dist{
+				Name:         line,
+				Provides:     make(map[string]string),
+				Requirements: make(CPAN.Requirements),
+			}
+			snap.Dists = append(snap.Dists, dist)
+			section = ""
+		case 4:
+			if dist == nil {
+				return nil, errorf("unexpected %q", line)
+			}
+			i := strings.IndexByte(line, ':')
+			if i < 0 {
+				return nil, errorf("key expected: %q", line)
+			}
+			key, value := line[:i], strings.TrimSpace(line[i+1:])
+			switch key {
+			case "pathname":
+				dist.Pathname = value
+			case "provides", "requirements":
+				if value != "" {
+					return nil, errorf("%s: unexpected value %q", key, value)
+				}
+				section = key
+			default:
+				return nil, errorf("unknown key %q", key)
+			}
+		case 6:
+			if dist == nil || section == "" {
+				return nil, errorf("unexpected %q", line)
+			}
+			module, version := line, ""
+			if i := strings.IndexByte(line, ' '); i >= 0 {
+				module, version = line[:i], strings.TrimSpace(line[i+1:])
+			}
+			if section == "provides" {
+				dist.Provides[module] = version
+			} else {
+				dist.Requirements[module] = version
+			}
+		default:
+			return nil, errorf("unexpected indentation")
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if lineNum == 0 {
+		return nil, &ParseError{Line: 1, Msg: "empty file"}
+	}
+	for _, d := range snap.Dists {
+		if d.Pathname == "" {
+			return nil, &ParseError{Line: lineNum, Msg: d.Name + ": pathname missing"}
+		}
+	}
+	return &snap, nil
+}
+
+// WriteSnapshot writes s in the format of Carton, with the distributions,
+// provides and requirements sorted.
+func WriteSnapshot(w io.Writer, s *Snapshot) error {
+	dists := append([]*Dist(nil), s.Dists...)
+	sort.Slice(dists, func(i, j int) bool {
+		return dists[i].Name < dists[j].Name
+	})
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%s\nDISTRIBUTIONS\n", SnapshotHeader)
+	for _, d := range dists {
+		fmt.Fprintf(bw, "  %s\n    pathname: %s\n", d.Name, d.Pathname)
+		fmt.Fprint(bw, "    provides:\n")
+		for _, p := range sortedKeys(d.Provides) {
+			v := d.Provides[p]
+			if v == "" {
+				v = "undef"
+			}
+			fmt.Fprintf(bw, "      %s %s\n", p, v)
+		}
+		fmt.Fprint(bw, "    requirements:\n")
+		for _, m := range sortedKeys(d.Requirements) {
+			v := d.Requirements[m]
+			if v == "" {
+				v = "0"
+			}
+			fmt.Fprintf(bw, "      %s %s\n", m, v)
+		}
+	}
+	return bw.Flush()
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// DistName returns the name of a release from its path: "Foo-Bar-1.02" for
+// "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz".
+func DistName(pathname string) string {
+	name := path.Base(pathname)
+	for _, ext := range []string{".tar.gz", ".tgz", ".tar.bz2", ".tbz", ".zip"} {
+		if strings.HasSuffix(name, ext) {
+			return strings.TrimSuffix(name, ext)
+		}
+	}
+	return name
+}
+
+// LockPhases are the phases of the prereqs recorded as requirements.
+var LockPhases = []string{"configure", "build", "runtime"}
+
+// FromPlan returns the snapshot of the distributions of an installation
+// plan. The provides are from META if available, or else the modules
+// required from each distribution.
+func FromPlan(plan resolver.Plan) *Snapshot {
+	s := &Snapshot{Dists: make([]*Dist, 0, len(plan))}
+	for _, step := range plan {
+		d := &Dist{
+			Name:         DistName(step.Dist),
+			Pathname:     step.Dist,
+			Provides:     make(map[string]string),
+			Requirements: make(CPAN.Requirements),
+		}
+		if step.Meta != nil && len(step.Meta.Provides) > 0 {
+			for pkg, p := range step.Meta.Provides {
+				d.Provides[pkg] = p.Version
+			}
+		} else {
+			for module, version := range step.Modules {
+				d.Provides[module] = version
+			}
+		}
+		if step.Meta != nil {
+			d.Requirements = step.Meta.Prereqs.Merge("requires", LockPhases...)
+		}
+		s.Dists = append(s.Dists, d)
+	}
+	sort.Slice(s.Dists, func(i, j int) bool {
+		return s.Dists[i].Name < s.Dists[j].Name
+	})
+	return s
+}
+
+// CheckSumsFunc returns the CHECKSUMS of an author directory, such as
+// "D/DO/DOLMEN". (*CPAN.Client).CheckSums is a CheckSumsFunc. A missing
+// CHECKSUMS is reported with an error that wraps CPAN.ErrNotFound.
+type CheckSumsFunc func(ctx context.Context, dir string) (map[string]CPAN.CheckSum, error)
+
+// Problem is a discrepancy found by Check.
+type Problem struct {
+	Dist string
+	Msg  string
+	// Fatal is true if the distribution can not be installed: the file is
+	// not on CPAN.
+	Fatal bool
+}
+
+func (p *Problem) String() string {
+	return p.Dist + ": " + p.Msg
+}
+
+// Check cross-checks the distributions of s with the packages index and
+// the CHECKSUMS of the authors. Either index or sums may be nil to skip the
+// corresponding check.
+//
+// A pathname missing from the CHECKSUMS of its author is fatal: the file has
+// been removed from CPAN (it may still be on BackPAN). Packages of the
+// snapshot that are now indexed in another distribution are reported as
+// non-fatal problems: the snapshot is outdated.
+func (s *Snapshot) Check(ctx context.Context, index resolver.Index, sums CheckSumsFunc) ([]*Problem, error) {
+	var problems []*Problem
+	dirs := make(map[string]map[string]CPAN.CheckSum)
+	for _, d := range s.Dists {
+		if sums != nil {
+			dir := path.Dir(d.Pathname)
+			list, ok := dirs[dir]
+			if !ok {
+				var err error
+				list, err = sums(ctx, dir)
+				if err != nil && !errors.Is(err, CPAN.ErrNotFound) {
+					return nil, fmt.Errorf("%s: %s", d.Name, err)
+				}
+				dirs[dir] = list
+			}
+			if _, ok = list[path.Base(d.Pathname)]; !ok {
+				problems = append(problems, &Problem{
+					Dist:  d.Name,
+					Msg:   fmt.Sprintf("%s not found in the CHECKSUMS of %s", path.Base(d.Pathname), dir),
+					Fatal: true,
+				})
+			}
+		}
+		if index != nil {
+			for _, pkg := range sortedKeys(d.Provides) {
+				e := index.Lookup(pkg)
+				switch {
+				case e == nil:
+					// Packages not indexed are common (private packages)
+				case e.Path != d.Pathname:
+					problems = append(problems, &Problem{
+						Dist: d.Name,
+						Msg:  fmt.Sprintf("%s %s is indexed from %s", pkg, e.Version, e.Path),
+					})
+				case e.Version != d.Provides[pkg] && CPAN.CompareVersions(e.Version, d.Provides[pkg]) != 0:
+					problems = append(problems, &Problem{
+						Dist: d.Name,
+						Msg:  fmt.Sprintf("%s: version %s in the snapshot, %s in the index", pkg, d.Provides[pkg], e.Version),
+					})
+				}
+			}
+		}
+	}
+	return problems, nil
+}
@@ -0,0 +1,153 @@
+package carton
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dolmen-go/CPAN"
+	"github.com/dolmen-go/CPAN/resolver"
+)
+
+func TestSnapshot(t *testing.T) {
+	src, err := ioutil.ReadFile("testdata/cpanfile.snapshot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := ReadSnapshot(bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Dists) != 2 {
+		t.Fatalf("got %d dists", len(s.Dists))
+	}
+	d := s.Dists[1]
+	if d.Name != "Foo-Bar-1.02" || d.Pathname != "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz" {
+		t.Errorf("got %+v", d)
+	}
+	if d.Provides["Foo::Bar::Baz"] != "0.5" || d.Provides["Foo::Bar::Undef"] != "undef" {
+		t.Errorf("provides: %v", d.Provides)
+	}
+	if d.Requirements["Scalar::Util"] != "1.50" || len(d.Requirements) != 4 {
+		t.Errorf("requirements: %v", d.Requirements)
+	}
+
+	// Round trip
+	var buf bytes.Buffer
+	if err = WriteSnapshot(&buf, s); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != string(src) {
+		t.Errorf("got:\n%s", buf.String())
+	}
+}
+
+func TestReadSnapshotErrors(t *testing.T) {
+	for _, test := range []struct {
+		src  string
+		line int
+	}{
+		{"# carton snapshot format: version 2.0\n", 1},
+		{SnapshotHeader + "\nDISTRIBUTIONS\n  Foo-1\n    pathname: F/FO/FOO/Foo-1.tar.gz\n    license: perl\n", 5},
+		{SnapshotHeader + "\nDISTRIBUTIONS\n      Foo 1\n", 3},
+		{SnapshotHeader + "\nDISTRIBUTIONS\n  Foo-1\n    provides:\n", 4},
+	} {
+		_, err := ReadSnapshot(strings.NewReader(test.src))
+		if e, ok := err.(*ParseError); !ok || e.Line != test.line {
+			t.Errorf("%q: got %v", test.src, err)
+		}
+	}
+}
+
+func TestFromPlan(t *testing.T) {
+	plan := resolver.Plan{
+		{
+			Dist:    "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz",
+			Modules: map[string]string{"Foo::Bar": "1.02"},
+			Meta: &CPAN.Meta{
+				Provides: map[string]CPAN.Provide{
+					"Foo::Bar":      {File: "lib/Foo/Bar.pm", Version: "1.02"},
+					"Foo::Bar::Baz": {File: "lib/Foo/Bar/Baz.pm"},
+				},
+				Prereqs: CPAN.Prereqs{
+					"runtime":   {"requires": {"JSON::PP": "0"}},
+					"configure": {"requires": {"ExtUtils::MakeMaker": "6.30"}},
+					"test":      {"requires": {"Test::More": "0.88"}},
+				},
+			},
+		},
+		{
+			Dist:    "A/AA/AAA/App-1.0.zip",
+			Modules: map[string]string{"App": "1.0"},
+		},
+	}
+	s := FromPlan(plan)
+	var buf bytes.Buffer
+	WriteSnapshot(&buf, s)
+	expected := SnapshotHeader + `
+DISTRIBUTIONS
+  App-1.0
+    pathname: A/AA/AAA/App-1.0.zip
+    provides:
+      App 1.0
+    requirements:
+  Foo-Bar-1.02
+    pathname: D/DO/DOLMEN/Foo-Bar-1.02.tar.gz
+    provides:
+      Foo::Bar 1.02
+      Foo::Bar::Baz undef
+    requirements:
+      ExtUtils::MakeMaker 6.30
+      JSON::PP 0
+`
+	if buf.String() != expected {
+		t.Errorf("got:\n%s", buf.String())
+	}
+}
+
+func TestCheck(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/cpanfile.snapshot")
+	s, err := ReadSnapshot(bytes.NewReader(f))
+	if err != nil {
+		t.Fatal(err)
+	}
+	index := resolver.NewIndexMap([]*CPAN.PackagesIndexEntry{
+		{Package: "Class::Tiny", Version: "1.008", Path: "D/DA/DAGOLDEN/Class-Tiny-1.008.tar.gz"},
+		{Package: "Foo::Bar", Version: "1.02", Path: "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz"},
+		{Package: "Foo::Bar::Baz", Version: "0.50", Path: "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz"},
+	})
+	// The error of a missing CHECKSUMS is the one of CPAN.Client: a mirror
+	// with no files
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+	client := &CPAN.Client{Mirrors: []string{srv.URL}}
+	sums := func(ctx context.Context, dir string) (map[string]CPAN.CheckSum, error) {
+		if dir == "D/DO/DOLMEN" {
+			return map[string]CPAN.CheckSum{"Foo-Bar-1.02.tar.gz": {}}, nil
+		}
+		return client.CheckSums(ctx, dir)
+	}
+	problems, err := s.Check(context.Background(), index, sums)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, p := range problems {
+		got = append(got, p.String())
+	}
+	expected := []string{
+		"Class-Tiny-1.006: Class-Tiny-1.006.tar.gz not found in the CHECKSUMS of D/DA/DAGOLDEN",
+		"Class-Tiny-1.006: Class::Tiny 1.008 is indexed from D/DA/DAGOLDEN/Class-Tiny-1.008.tar.gz",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %q", got)
+	}
+	if !problems[0].Fatal || problems[1].Fatal {
+		t.Error("Fatal")
+	}
+}
@@ -2,12 +2,19 @@ package CPAN
 
 import (
 	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"hash"
 	"io"
 	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"golang.org/x/crypto/openpgp"
 	"golang.org/x/crypto/openpgp/clearsign"
@@ -27,6 +34,44 @@ var (
 	ErrSyntax = errors.New("syntax error")
 )
 
+// CheckSumVerifier computes the size and sha256 of the data written to it
+// to compare them with a CheckSum.
+type CheckSumVerifier struct {
+	sum  *CheckSum
+	size int64
+	h    hash.Hash
+}
+
+// NewCheckSumVerifier returns a CheckSumVerifier for sum.
+func NewCheckSumVerifier(sum *CheckSum) *CheckSumVerifier {
+	return &CheckSumVerifier{sum: sum, h: sha256.New()}
+}
+
+func (v *CheckSumVerifier) Write(b []byte) (int, error) {
+	v.size += int64(len(b))
+	return v.h.Write(b)
+}
+
+// Verify checks the data written so far against the expected CheckSum.
+func (v *CheckSumVerifier) Verify() error {
+	if v.size != int64(v.sum.Size) {
+		return fmt.Errorf("size mismatch: got %d, expected %d", v.size, v.sum.Size)
+	}
+	if got := hex.EncodeToString(v.h.Sum(nil)); got != v.sum.Sha256 {
+		return fmt.Errorf("sha256 mismatch: got %s, expected %s", got, v.sum.Sha256)
+	}
+	return nil
+}
+
+// Verify reads r up to EOF and checks its size and sha256.
+func (sum *CheckSum) Verify(r io.Reader) error {
+	v := NewCheckSumVerifier(sum)
+	if _, err := io.Copy(v, r); err != nil {
+		return err
+	}
+	return v.Verify()
+}
+
 func parseCheckSums(buf []byte) (map[string]CheckSum, error) {
 	// Skip perl comments
 	for {
@@ -45,11 +90,11 @@ func parseCheckSums(buf []byte) (map[string]CheckSum, error) {
 	}
 
 	i := bytes.IndexByte(buf, '{')
-	buf = buf[i:]
-	j := bytes.LastIndexByte(buf, '}')
 	if i == -1 {
 		return nil, ErrSyntax
 	}
+	buf = buf[i:]
+	j := bytes.LastIndexByte(buf, '}')
 	if j == -1 {
 		return nil, ErrSyntax
 	}
@@ -86,6 +131,20 @@ func parseCheckSums(buf []byte) (map[string]CheckSum, error) {
 	return checksums, nil
 }
 
+// ReadUnsignedCheckSums loads the content of a CHECKSUMS file without
+// verifying any signature. This is only suitable for trusted sources, such
+// as CHECKSUMS files generated locally by WriteCheckSums.
+func ReadUnsignedCheckSums(r io.Reader) (map[string]CheckSum, error) {
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if block, _ := clearsign.Decode(content); block != nil {
+		content = block.Bytes
+	}
+	return parseCheckSums(content)
+}
+
 // ReadCheckSums loads the content of a CHECKSUMS file.
 // The PGP signature is verified.
 func ReadCheckSums(r io.Reader, keyring openpgp.KeyRing) (map[string]CheckSum, error) {
@@ -115,6 +174,9 @@ func ReadCheckSums(r io.Reader, keyring openpgp.KeyRing) (map[string]CheckSum, e
 	var verifySignature func(pubkey *packet.PublicKey) error
 	switch sig := pkt.(type) {
 	case *packet.Signature:
+		if sig.IssuerKeyId == nil {
+			return nil, errors.New("invalid signature: no issuer key id")
+		}
 		keyId = *sig.IssuerKeyId
 		hash = sig.Hash.New()
 		verifySignature = func(pubkey *packet.PublicKey) error {
@@ -150,3 +212,69 @@ func ReadCheckSums(r io.Reader, keyring openpgp.KeyRing) (map[string]CheckSum, e
 
 	return parseCheckSums(block.Bytes)
 }
+
+// ComputeCheckSum reads r up to EOF and returns its size, md5 and sha256.
+// MTime is left empty.
+func ComputeCheckSum(r io.Reader) (CheckSum, error) {
+	hMD5 := md5.New()
+	hSha256 := sha256.New()
+	n, err := io.Copy(io.MultiWriter(hMD5, hSha256), r)
+	if err != nil {
+		return CheckSum{}, err
+	}
+	return CheckSum{
+		MD5:    hex.EncodeToString(hMD5.Sum(nil)),
+		Sha256: hex.EncodeToString(hSha256.Sum(nil)),
+		Size:   int(n),
+	}, nil
+}
+
+// validCheckSumsString reports whether s can be written in a CHECKSUMS file
+// and read back by parseCheckSums.
+func validCheckSumsString(s string) bool {
+	if !utf8.ValidString(s) || strings.ContainsAny(s, `'"\`) || strings.Contains(s, "=>") {
+		return false
+	}
+	for _, c := range s {
+		if c < ' ' {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteCheckSums writes sums in the format of CPAN::Checksums, unsigned.
+//
+// ErrSyntax is returned for names and values that could not be read back:
+// quotes, backslashes, "=>", control characters and invalid UTF-8.
+func WriteCheckSums(w io.Writer, sums map[string]CheckSum) error {
+	names := make([]string, 0, len(sums))
+	for name, sum := range sums {
+		for _, s := range []string{name, sum.MD5, sum.MTime, sum.Sha256} {
+			if !validCheckSumsString(s) {
+				return fmt.Errorf("%q: %w", s, ErrSyntax)
+			}
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString("# CHECKSUMS file written on " + time.Now().UTC().Format("Mon Jan _2 15:04:05 2006") + " GMT by github.com/dolmen-go/CPAN\n$cksum = {\n")
+	for i, name := range names {
+		if i > 0 {
+			buf.WriteString(",\n")
+		}
+		sum := sums[name]
+		fmt.Fprintf(&buf, "  '%s' => {\n", name)
+		if sum.IsDir != 0 {
+			buf.WriteString("    'isdir' => 1\n  }")
+			continue
+		}
+		fmt.Fprintf(&buf, "    'md5' => '%s',\n    'mtime' => '%s',\n    'sha256' => '%s',\n    'size' => %d\n  }",
+			sum.MD5, sum.MTime, sum.Sha256, sum.Size)
+	}
+	buf.WriteString("\n};\n__END__\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
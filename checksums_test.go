@@ -1,11 +1,17 @@
 package CPAN
 
 import (
+	"bytes"
+	"crypto"
 	"crypto/dsa"
 	"errors"
 	//"fmt"
+	"io/ioutil"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"golang.org/x/crypto/openpgp"
 	"golang.org/x/crypto/openpgp/armor"
@@ -67,4 +73,90 @@ func TestReadChecksums(t *testing.T) {
 	}
 
 	t.Logf("%+v", checksums)
+	if len(checksums) != 69 {
+		t.Errorf("got %d checksums", len(checksums))
+	}
+	if sum := checksums["ARGV-Abs-1.01.tar.gz"]; sum.Size != 10414 || sum.MTime != "2011-11-13" || sum.Sha256 != "83359cf22fa511183127edddc9896ba298c1ee9a1c42eac235562a4b262e2cd7" {
+		t.Errorf("ARGV-Abs-1.01.tar.gz: got %+v", sum)
+	}
+}
+
+func TestCheckSumsRoundTrip(t *testing.T) {
+	r, err := os.Open("testdata/CHECKSUMS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	checksums, err := ReadUnsignedCheckSums(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err = WriteCheckSums(&buf, checksums); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadUnsignedCheckSums(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, checksums) {
+		t.Errorf("got %+v", got)
+	}
+
+	sum := checksums["ARGV-Abs-1.01.tar.gz"]
+	for _, name := range []string{"a b", "Foo=1.0", "a>b", "{Foo}", "#Foo", "Ünïcode-1.0.tar.gz", "$cksum"} {
+		buf.Reset()
+		sums := map[string]CheckSum{name: sum, "sub": {IsDir: 1}}
+		if err = WriteCheckSums(&buf, sums); err != nil {
+			t.Errorf("%q: %v", name, err)
+			continue
+		}
+		got, err := ReadUnsignedCheckSums(&buf)
+		if err != nil || !reflect.DeepEqual(got, sums) {
+			t.Errorf("%q: got %+v, %v", name, got, err)
+		}
+	}
+
+	for _, name := range []string{`a"b`, "a=>b", "a\nb", "a\tb", "a'b", `a\b`, "a\xffb"} {
+		if err = WriteCheckSums(ioutil.Discard, map[string]CheckSum{name: sum}); !errors.Is(err, ErrSyntax) {
+			t.Errorf("%q: got %v", name, err)
+		}
+		bad := sum
+		bad.MTime = name
+		if err = WriteCheckSums(ioutil.Discard, map[string]CheckSum{"Foo-1.0.tar.gz": bad}); !errors.Is(err, ErrSyntax) {
+			t.Errorf("mtime %q: got %v", name, err)
+		}
+	}
+}
+
+func TestReadCheckSumsNoIssuer(t *testing.T) {
+	signer, err := openpgp.NewEntity("PAUSE test", "", "pause@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var signed bytes.Buffer
+	signed.WriteString("-----BEGIN PGP SIGNED MESSAGE-----\nHash: SHA256\n\n")
+	WriteCheckSums(&signed, map[string]CheckSum{"Foo-1.0.tar.gz": {Size: 1}})
+
+	sig := &packet.Signature{
+		SigType:      packet.SigTypeText,
+		PubKeyAlgo:   signer.PrivateKey.PubKeyAlgo,
+		Hash:         crypto.SHA256,
+		CreationTime: time.Now(),
+	}
+	if err = sig.Sign(sig.Hash.New(), signer.PrivateKey, nil); err != nil {
+		t.Fatal(err)
+	}
+	w, err := armor.Encode(&signed, openpgp.SignatureType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig.Serialize(w)
+	w.Close()
+	signed.WriteString("\n")
+
+	_, err = ReadCheckSums(&signed, openpgp.EntityList{signer})
+	if err == nil || !strings.Contains(err.Error(), "no issuer") {
+		t.Errorf("got %v", err)
+	}
 }
@@ -0,0 +1,328 @@
+package CPAN
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/openpgp"
+)
+
+// DefaultMirror is the mirror used by a Client without Mirrors.
+const DefaultMirror = "https://www.cpan.org/"
+
+// Paths of the index files, relative to the root of a mirror.
+const (
+	PackagesIndexPath = "modules/02packages.details.txt.gz"
+	MailRCPath        = "authors/01mailrc.txt.gz"
+	PermsPath         = "modules/06perms.txt.gz"
+)
+
+// ErrNotFound is returned, wrapped with the path of the file, when a file is
+// missing on all mirrors. Use errors.Is to check for it.
+var ErrNotFound = errors.New("not found")
+
+// Client fetches files from CPAN mirrors.
+//
+// Files under authors/id are verified: the signature of CHECKSUMS files is
+// checked with KeyRing, and other files are checked against the CHECKSUMS of
+// their directory.
+type Client struct {
+	// Mirrors are the base URLs of the mirrors, tried in order.
+	// DefaultMirror is used if empty.
+	Mirrors []string
+	// HTTPClient is used for all requests. http.DefaultClient is used if nil.
+	HTTPClient *http.Client
+	// CacheDir, if not empty, is where fetched files are kept. Cached files
+	// are revalidated with conditional requests (ETag and Last-Modified),
+	// except distribution files which never change once uploaded.
+	CacheDir string
+	// KeyRing verifies CHECKSUMS signatures. PAUSEKeyRing is used if nil.
+	KeyRing openpgp.KeyRing
+	// Retries is the number of additional rounds over all the mirrors after
+	// a failure.
+	Retries int
+	// RetryDelay is the pause before each additional round.
+	RetryDelay time.Duration
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (c *Client) keyRing() openpgp.KeyRing {
+	if c.KeyRing != nil {
+		return c.KeyRing
+	}
+	return PAUSEKeyRing
+}
+
+func (c *Client) mirrors() []string {
+	if len(c.Mirrors) == 0 {
+		return []string{DefaultMirror}
+	}
+	return c.Mirrors
+}
+
+// PackagesIndex fetches 02packages.details.txt.gz, to be read with
+// ReadPackagesIndex.
+func (c *Client) PackagesIndex(ctx context.Context) (io.ReadCloser, error) {
+	return c.Get(ctx, PackagesIndexPath)
+}
+
+// MailRC fetches 01mailrc.txt.gz, to be read with ReadMailRC.
+func (c *Client) MailRC(ctx context.Context) (io.ReadCloser, error) {
+	return c.Get(ctx, MailRCPath)
+}
+
+// Perms fetches 06perms.txt.gz, to be read with ReadPerms.
+func (c *Client) Perms(ctx context.Context) (io.ReadCloser, error) {
+	return c.Get(ctx, PermsPath)
+}
+
+// Dist fetches a distribution file. distPath is relative to authors/id, as
+// in PackagesIndexEntry.Path.
+func (c *Client) Dist(ctx context.Context, distPath string) (io.ReadCloser, error) {
+	return c.Get(ctx, "authors/id/"+distPath)
+}
+
+// CheckSums fetches and verifies the CHECKSUMS of an author directory.
+// dir may be relative to authors/id ("D/DO/DOLMEN") or to the root of the
+// mirror ("authors/id/D/DO/DOLMEN").
+func (c *Client) CheckSums(ctx context.Context, dir string) (map[string]CheckSum, error) {
+	if !strings.HasPrefix(dir, "authors/id/") {
+		dir = "authors/id/" + dir
+	}
+	p := strings.TrimSuffix(dir, "/") + "/CHECKSUMS"
+	var sums map[string]CheckSum
+	r, err := c.fetch(ctx, p, false, func(r io.Reader) (err error) {
+		sums, err = ReadCheckSums(r, c.keyRing())
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	if sums == nil {
+		// Served from the cache without verification
+		defer r.Close()
+		return ReadCheckSums(r, c.keyRing())
+	}
+	r.Close()
+	return sums, nil
+}
+
+// Get fetches the file p (relative to the root of the mirror).
+func (c *Client) Get(ctx context.Context, p string) (io.ReadCloser, error) {
+	if err := checkPath(p); err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(p, "authors/id/") {
+		return c.fetch(ctx, p, false, nil)
+	}
+
+	dir, name := path.Split(p)
+	if name == "CHECKSUMS" {
+		return c.fetch(ctx, p, false, func(r io.Reader) error {
+			_, err := ReadCheckSums(r, c.keyRing())
+			return err
+		})
+	}
+
+	sums, err := c.CheckSums(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	sum, ok := sums[name]
+	if !ok {
+		return nil, fmt.Errorf("%s: not listed in CHECKSUMS", p)
+	}
+	return c.fetch(ctx, p, true, sum.Verify)
+}
+
+// checkPath rejects paths that are not clean relative paths.
+func checkPath(p string) error {
+	if cp := path.Clean(p); cp != p || path.IsAbs(p) || cp == ".." || strings.HasPrefix(cp, "../") {
+		return fmt.Errorf("invalid path %q", p)
+	}
+	return nil
+}
+
+// cacheMeta is stored next to each cached file.
+type cacheMeta struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+const cacheMetaSuffix = ".http.json"
+
+// fetch gets p from the first mirror that delivers it. check, if not nil,
+// validates the full content. Immutable files found in the cache are
+// returned without any request.
+func (c *Client) fetch(ctx context.Context, p string, immutable bool, check func(io.Reader) error) (io.ReadCloser, error) {
+	var (
+		cached string
+		meta   *cacheMeta
+	)
+	if c.CacheDir != "" {
+		cached = filepath.Join(c.CacheDir, filepath.FromSlash(p))
+		if immutable {
+			if f, err := os.Open(cached); err == nil {
+				return f, nil
+			}
+		} else if _, err := os.Stat(cached); err == nil {
+			meta = new(cacheMeta)
+			if buf, err := ioutil.ReadFile(cached + cacheMetaSuffix); err == nil {
+				json.Unmarshal(buf, meta)
+			}
+		}
+	}
+
+	var r io.ReadCloser
+	err := c.tryMirrors(ctx, p, func(url string) (err error) {
+		r, err = c.try(ctx, url, cached, meta, check)
+		return
+	})
+	if err == nil {
+		return r, nil
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	// Fallback to the stale copy
+	if meta != nil {
+		if f, err := os.Open(cached); err == nil {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: %w", p, err)
+}
+
+// tryMirrors calls try with the URL of p on each mirror, for each round of
+// retries, until it succeeds. The error is ErrNotFound if all the mirrors
+// returned ErrNotFound, or else the last error.
+func (c *Client) tryMirrors(ctx context.Context, p string, try func(url string) error) error {
+	var lastErr error
+	notFound := true
+	for round := 0; round <= c.Retries; round++ {
+		if round > 0 && c.RetryDelay > 0 {
+			select {
+			case <-time.After(c.RetryDelay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		for _, mirror := range c.mirrors() {
+			err := try(strings.TrimRight(mirror, "/") + "/" + p)
+			if err == nil {
+				return nil
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != ErrNotFound {
+				notFound = false
+				lastErr = err
+			}
+		}
+	}
+	if notFound {
+		return ErrNotFound
+	}
+	return lastErr
+}
+
+func (c *Client) try(ctx context.Context, url string, cached string, meta *cacheMeta, check func(io.Reader) error) (io.ReadCloser, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if meta != nil {
+		if meta.ETag != "" {
+			req.Header.Set("If-None-Match", meta.ETag)
+		}
+		if meta.LastModified != "" {
+			req.Header.Set("If-Modified-Since", meta.LastModified)
+		}
+	}
+	resp, err := c.httpClient().Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		if meta != nil {
+			return os.Open(cached)
+		}
+		fallthrough
+	case http.StatusNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, fmt.Errorf("%s: HTTP status %s", url, resp.Status)
+	}
+
+	if cached == "" {
+		buf, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		if check != nil {
+			if err = check(bytes.NewReader(buf)); err != nil {
+				return nil, fmt.Errorf("%s: %s", url, err)
+			}
+		}
+		return ioutil.NopCloser(bytes.NewReader(buf)), nil
+	}
+
+	return storeCache(cached, resp, check)
+}
+
+// storeCache writes the body of resp to the cache file cached, and returns
+// it opened for reading.
+func storeCache(cached string, resp *http.Response, check func(io.Reader) error) (io.ReadCloser, error) {
+	err := writeFileAtomic(cached, func(tmp *os.File) error {
+		if _, err := io.Copy(tmp, resp.Body); err != nil {
+			return err
+		}
+		if check == nil {
+			return nil
+		}
+		if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if err := check(tmp); err != nil {
+			return fmt.Errorf("%s: %s", resp.Request.URL, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	meta := cacheMeta{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}
+	if buf, err := json.Marshal(&meta); err == nil {
+		ioutil.WriteFile(cached+cacheMetaSuffix, buf, 0644)
+	}
+
+	return os.Open(cached)
+}
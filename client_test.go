@@ -0,0 +1,108 @@
+package CPAN
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient(t *testing.T) {
+	checksums, err := ioutil.ReadFile("testdata/CHECKSUMS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	packages := []byte("fake 02packages content")
+	modTime := time.Date(2016, 11, 27, 16, 52, 43, 0, time.UTC)
+
+	var requests, notModified int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authors/id/D/DO/DOLMEN/CHECKSUMS", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write(checksums)
+	})
+	mux.HandleFunc("/authors/id/D/DO/DOLMEN/Git-Sub-0.163320.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte("corrupted"))
+	})
+	mux.HandleFunc("/modules/02packages.details.txt.gz", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+		}
+		http.ServeContent(w, r, "", modTime, bytes.NewReader(packages))
+	})
+	good := httptest.NewServer(mux)
+	defer good.Close()
+
+	var failures int
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		failures++
+		http.Error(w, "broken mirror", http.StatusInternalServerError)
+	}))
+	defer bad.Close()
+
+	cacheDir, err := ioutil.TempDir("", "cpan-client-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(cacheDir)
+
+	c := &Client{
+		Mirrors:  []string{bad.URL, good.URL + "/"},
+		CacheDir: cacheDir,
+	}
+	ctx := context.Background()
+
+	sums, err := c.CheckSums(ctx, "D/DO/DOLMEN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := sums["Git-Sub-0.163320.tar.gz"]; !ok {
+		t.Error("Git-Sub-0.163320.tar.gz not found in CHECKSUMS")
+	}
+	if failures != 1 {
+		t.Errorf("bad mirror: got %d requests", failures)
+	}
+
+	for i := 0; i < 2; i++ {
+		r, err := c.PackagesIndex(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, _ := ioutil.ReadAll(r)
+		r.Close()
+		if !bytes.Equal(got, packages) {
+			t.Errorf("02packages: got %q", got)
+		}
+	}
+	if notModified != 1 {
+		t.Errorf("conditional requests: got %d, expected 1", notModified)
+	}
+
+	_, err = c.Dist(ctx, "D/DO/DOLMEN/Git-Sub-0.163320.tar.gz")
+	if err == nil || !strings.Contains(err.Error(), "mismatch") {
+		t.Errorf("corrupted file: got error %v", err)
+	}
+	if _, err = os.Stat(cacheDir + "/authors/id/D/DO/DOLMEN/Git-Sub-0.163320.tar.gz"); err == nil {
+		t.Error("corrupted file stored in the cache")
+	}
+
+	// A file missing on all the mirrors
+	_, err = (&Client{Mirrors: []string{good.URL}}).Get(ctx, "modules/03modlist.data.gz")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("missing file: got error %v", err)
+	}
+
+	_, err = c.Get(ctx, "modules/../../etc/passwd")
+	if err == nil {
+		t.Error("invalid path accepted")
+	}
+}
@@ -0,0 +1,131 @@
+// Command cpan-audit reports the security advisories of a CPANSA database
+// that affect a set of distributions.
+//
+//	cpan-audit -db cpansa.json -snapshot cpanfile.snapshot
+//	cpan-audit -db cpansa.json -index 02packages.details.txt.gz
+//	cpan-audit -db cpansa.json -modules installed.txt
+//	cpan-audit -db cpansa.json D/DO/DOLMEN/Foo-Bar-1.02.tar.gz...
+//
+// The modules list has one module per line followed by its version.
+// The exit code is 3 if advisories are found, 1 on errors.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dolmen-go/CPAN"
+	"github.com/dolmen-go/CPAN/audit"
+	"github.com/dolmen-go/CPAN/carton"
+)
+
+func main() {
+	dbFile := flag.String("db", "", "advisory database `file` (JSON or YAML)")
+	snapshot := flag.String("snapshot", "", "check the distributions of a cpanfile.snapshot `file`")
+	index := flag.String("index", "", "check the distributions of a 02packages.details.txt.gz `file`")
+	modules := flag.String("modules", "", "check the installed modules listed in `file`")
+	asJSON := flag.Bool("json", false, "JSON output")
+	flag.Parse()
+
+	if *dbFile == "" || (*snapshot == "" && *index == "" && *modules == "" && flag.NArg() == 0) {
+		fmt.Fprintln(os.Stderr, "usage: cpan-audit -db cpansa.json [-json] [-snapshot cpanfile.snapshot] [-index 02packages.details.txt.gz] [-modules list] [dist path...]")
+		os.Exit(2)
+	}
+
+	findings, err := run(*dbFile, *snapshot, *index, *modules, flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if *asJSON {
+		if findings == nil {
+			findings = []*audit.Finding{}
+		}
+		buf, _ := json.MarshalIndent(findings, "", "  ")
+		fmt.Printf("%s\n", buf)
+	} else {
+		for _, f := range findings {
+			fmt.Printf("%s\t%s\n", f, f.Source)
+		}
+	}
+	if len(findings) > 0 {
+		os.Exit(3)
+	}
+}
+
+func run(dbFile, snapshot, index, modules string, dists []string) ([]*audit.Finding, error) {
+	db, err := audit.LoadDB(dbFile)
+	if err != nil {
+		return nil, err
+	}
+
+	findings := db.CheckDists(dists...)
+
+	if snapshot != "" {
+		f, err := os.Open(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		s, err := carton.ReadSnapshot(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, db.CheckSnapshot(s)...)
+	}
+
+	if index != "" {
+		f, err := os.Open(index)
+		if err != nil {
+			return nil, err
+		}
+		_, ch, done := CPAN.ReadPackagesIndex(bufio.NewReader(f))
+		var entries []*CPAN.PackagesIndexEntry
+		for e := range ch {
+			entries = append(entries, e)
+		}
+		err = <-done
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", index, err)
+		}
+		findings = append(findings, db.CheckIndex(entries)...)
+	}
+
+	if modules != "" {
+		list, err := readModules(modules)
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, db.CheckModules(list)...)
+	}
+	return findings, nil
+}
+
+// readModules reads a list of modules with their version, one per line.
+func readModules(file string) (map[string]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	modules := make(map[string]string)
+	s := bufio.NewScanner(f)
+	lineNum := 0
+	for s.Scan() {
+		lineNum++
+		fields := strings.Fields(s.Text())
+		switch {
+		case len(fields) == 0 || strings.HasPrefix(fields[0], "#"):
+		case len(fields) == 2:
+			modules[fields[0]] = fields[1]
+		default:
+			return nil, fmt.Errorf("%s:%d: module and version expected", file, lineNum)
+		}
+	}
+	return modules, s.Err()
+}
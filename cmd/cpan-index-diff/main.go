@@ -0,0 +1,68 @@
+// Command cpan-index-diff compares two 02packages.details.txt.gz files and
+// lists the packages added, removed, upgraded, downgraded or moved.
+//
+//	cpan-index-diff [-json] old/02packages.details.txt.gz new/02packages.details.txt.gz
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+func main() {
+	asJSON := flag.Bool("json", false, "output a JSON array")
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		fmt.Fprintln(os.Stderr, "usage: cpan-index-diff [-json] old.txt.gz new.txt.gz")
+		os.Exit(2)
+	}
+
+	oldFile, err := os.Open(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer oldFile.Close()
+	newFile, err := os.Open(flag.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer newFile.Close()
+
+	w := bufio.NewWriter(os.Stdout)
+	sep := "[\n"
+	err = CPAN.DiffPackagesIndex(oldFile, newFile, func(d *CPAN.PackageDiff) error {
+		if !*asJSON {
+			_, err := fmt.Fprintln(w, d)
+			return err
+		}
+		w.WriteString(sep)
+		sep = ",\n"
+		buf, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(buf)
+		return err
+	})
+	if *asJSON {
+		if sep[0] == '[' {
+			w.WriteString("[")
+		}
+		w.WriteString("\n]\n")
+	}
+	if e := w.Flush(); err == nil {
+		err = e
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,45 @@
+// Command cpan-inject adds distribution archives to a local CPAN repository
+// (a DarkPAN) and updates its indexes.
+//
+//	cpan-inject -root /srv/darkpan -author ACME Foo-Bar-1.02.tar.gz
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/dolmen-go/CPAN/darkpan"
+)
+
+func main() {
+	root := flag.String("root", ".", "root `directory` of the repository")
+	author := flag.String("author", "DUMMY", "PAUSE `ID` of the author")
+	verbose := flag.Bool("v", false, "verbose")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: cpan-inject [-root dir] [-author ID] dist.tar.gz...")
+		os.Exit(2)
+	}
+
+	repo := &darkpan.Repo{Root: *root}
+	if *verbose {
+		repo.Logf = log.Printf
+	}
+
+	status := 0
+	for _, file := range flag.Args() {
+		entries, err := repo.Inject(file, *author)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			status = 1
+			continue
+		}
+		for _, e := range entries {
+			fmt.Printf("%s\t%s\t%s\n", e.Package, e.Version, e.Path)
+		}
+	}
+	os.Exit(status)
+}
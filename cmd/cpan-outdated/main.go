@@ -0,0 +1,202 @@
+// Command cpan-outdated lists the releases to install to upgrade the
+// outdated modules of Perl library directories, like cpan-outdated.
+//
+//	cpan-outdated -l local | cpanm
+//	cpan-outdated -I /usr/local/lib/perl5 -I /usr/local/share/perl5 --exclude-core
+//	cpan-outdated -list installed.txt -index 02packages.details.txt.gz -json
+//
+// The list file has one module per line followed by its installed version.
+// The index is fetched from the mirror if no -index file is given.
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dolmen-go/CPAN"
+	"github.com/dolmen-go/CPAN/corelist"
+	"github.com/dolmen-go/CPAN/installed"
+	"github.com/dolmen-go/CPAN/resolver"
+)
+
+type stringsFlag []string
+
+func (s *stringsFlag) String() string     { return strings.Join(*s, ",") }
+func (s *stringsFlag) Set(v string) error { *s = append(*s, v); return nil }
+
+// Upgrade is a release that upgrades outdated modules.
+type Upgrade struct {
+	// Path is relative to authors/id.
+	Path    string                `json:"path"`
+	Modules []*installed.Outdated `json:"modules"`
+}
+
+func main() {
+	var libs, localLibs stringsFlag
+	flag.Var(&libs, "I", "library `directory` (repeatable)")
+	flag.Var(&localLibs, "l", "local::lib `directory`, for its lib/perl5 (repeatable)")
+	list := flag.String("list", "", "read the installed modules and versions from `file` instead")
+	indexFile := flag.String("index", "", "02packages.details.txt.gz `file`")
+	mirror := flag.String("mirror", CPAN.DefaultMirror, "`URL` of the CPAN mirror to fetch the index from")
+	excludeCore := flag.Bool("exclude-core", false, "exclude the modules distributed with perl, unless upgraded")
+	perl := flag.String("perl", "", "`version` of perl for -exclude-core (default: the latest known)")
+	verbose := flag.Bool("v", false, "list the outdated modules with their versions")
+	asJSON := flag.Bool("json", false, "JSON output")
+	flag.Parse()
+
+	for _, l := range localLibs {
+		libs = append(libs, filepath.Join(l, "lib", "perl5"))
+	}
+	if (len(libs) == 0) == (*list == "") || flag.NArg() > 0 {
+		fmt.Fprintln(os.Stderr, "usage: cpan-outdated (-I dir... | -l dir... | -list file) [-index file] [-exclude-core [-perl version]] [-v] [-json]")
+		os.Exit(2)
+	}
+
+	upgrades, err := run(libs, *list, *indexFile, *mirror, *excludeCore, *perl)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	switch {
+	case *asJSON:
+		if upgrades == nil {
+			upgrades = []*Upgrade{}
+		}
+		buf, _ := json.MarshalIndent(upgrades, "", "  ")
+		fmt.Fprintf(w, "%s\n", buf)
+	case *verbose:
+		for _, u := range upgrades {
+			for _, o := range u.Modules {
+				fmt.Fprintf(w, "%-40s %10s %10s  %s\n", o.Module, o.Installed, o.Latest, u.Path)
+			}
+		}
+	default:
+		for _, u := range upgrades {
+			fmt.Fprintln(w, u.Path)
+		}
+	}
+	if err = w.Flush(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(libs []string, list, indexFile, mirror string, excludeCore bool, perl string) ([]*Upgrade, error) {
+	var inv *installed.Inventory
+	var err error
+	if list != "" {
+		inv, err = readList(list)
+	} else {
+		inv, err = installed.Scan(libs...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if excludeCore {
+		if perl == "" {
+			releases := corelist.Releases()
+			perl = releases[len(releases)-1]
+		}
+		core, err := corelist.Modules(perl)
+		if err != nil {
+			return nil, err
+		}
+		for module, m := range inv.Modules {
+			// Dual-life modules upgraded from CPAN, in site_perl or in a
+			// local::lib, are newer than their core version
+			if version, ok := core[module]; ok && CPAN.CompareVersions(m.Version, version) <= 0 {
+				delete(inv.Modules, module)
+			}
+		}
+	}
+
+	index, err := readIndex(indexFile, mirror, inv)
+	if err != nil {
+		return nil, err
+	}
+
+	byPath := make(map[string]*Upgrade)
+	var upgrades []*Upgrade
+	for _, o := range inv.Outdated(index) {
+		u := byPath[o.Path]
+		if u == nil {
+			u = &Upgrade{Path: o.Path}
+			byPath[o.Path] = u
+			upgrades = append(upgrades, u)
+		}
+		u.Modules = append(u.Modules, o)
+	}
+	sort.Slice(upgrades, func(i, j int) bool {
+		return upgrades[i].Path < upgrades[j].Path
+	})
+	return upgrades, nil
+}
+
+// readList reads a list of modules with their version, one per line.
+func readList(file string) (*installed.Inventory, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	inv := &installed.Inventory{
+		Modules: make(map[string]*installed.Module),
+		Dists:   make(map[string]*installed.Dist),
+	}
+	s := bufio.NewScanner(f)
+	lineNum := 0
+	for s.Scan() {
+		lineNum++
+		fields := strings.Fields(s.Text())
+		switch {
+		case len(fields) == 0 || strings.HasPrefix(fields[0], "#"):
+		case len(fields) == 2:
+			inv.Modules[fields[0]] = &installed.Module{Name: fields[0], Version: fields[1]}
+		default:
+			return nil, fmt.Errorf("%s:%d: module and version expected", file, lineNum)
+		}
+	}
+	return inv, s.Err()
+}
+
+// readIndex reads the entries of 02packages of the modules of inv.
+func readIndex(indexFile, mirror string, inv *installed.Inventory) (resolver.IndexMap, error) {
+	var r io.ReadCloser
+	var err error
+	if indexFile != "" {
+		r, err = os.Open(indexFile)
+	} else {
+		c := &CPAN.Client{Mirrors: []string{mirror}}
+		r, err = c.PackagesIndex(context.Background())
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	index := make(resolver.IndexMap)
+	_, entries, done := CPAN.ReadPackagesIndex(bufio.NewReader(r))
+	for e := range entries {
+		if _, ok := inv.Modules[e.Package]; ok {
+			index[e.Package] = e
+		}
+	}
+	if err = <-done; err != nil {
+		if indexFile == "" {
+			indexFile = CPAN.PackagesIndexPath
+		}
+		return nil, fmt.Errorf("%s: %s", indexFile, err)
+	}
+	return index, nil
+}
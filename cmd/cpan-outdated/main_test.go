@@ -0,0 +1,103 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/dolmen-go/CPAN"
+	"github.com/dolmen-go/CPAN/corelist"
+)
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cpan-outdated-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	const perl = "5.036000"
+	coreVersion, ok := corelist.ModuleVersion("Test::More", perl)
+	if !ok {
+		t.Fatal("Test::More is not a core module")
+	}
+
+	list := filepath.Join(dir, "installed.txt")
+	if err = ioutil.WriteFile(list, []byte(`# module version
+Foo::Bar 1.0
+Test::More `+coreVersion+`
+JSON::PP 98
+Up::ToDate 2
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	index := filepath.Join(dir, "02packages.details.txt.gz")
+	f, err := os.Create(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = CPAN.WritePackagesIndex(f, nil, []*CPAN.PackagesIndexEntry{
+		{Package: "Foo::Bar", Version: "1.1", Path: "D/DO/DOLMEN/Foo-Bar-1.1.tar.gz"},
+		{Package: "Foo::Bar::Baz", Version: "1.1", Path: "D/DO/DOLMEN/Foo-Bar-1.1.tar.gz"},
+		{Package: "JSON::PP", Version: "99", Path: "I/IS/ISHIGAKI/JSON-PP-99.tar.gz"},
+		{Package: "Test::More", Version: "99", Path: "E/EX/EXODIST/Test-Simple-99.tar.gz"},
+		{Package: "Up::ToDate", Version: "2", Path: "A/AU/AUTHOR/Up-ToDate-2.tar.gz"},
+	})
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		excludeCore bool
+		expected    []string
+	}{
+		{false, []string{
+			"D/DO/DOLMEN/Foo-Bar-1.1.tar.gz",
+			"E/EX/EXODIST/Test-Simple-99.tar.gz",
+			"I/IS/ISHIGAKI/JSON-PP-99.tar.gz",
+		}},
+		// JSON::PP is newer than the core version: it has been upgraded
+		{true, []string{
+			"D/DO/DOLMEN/Foo-Bar-1.1.tar.gz",
+			"I/IS/ISHIGAKI/JSON-PP-99.tar.gz",
+		}},
+	} {
+		upgrades, err := run(nil, list, index, "", test.excludeCore, perl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, u := range upgrades {
+			got = append(got, u.Path)
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("exclude-core=%t: got %q", test.excludeCore, got)
+		}
+	}
+
+	// A local::lib with a dual-life module upgraded from CPAN
+	lib := filepath.Join(dir, "local", "lib", "perl5")
+	if err = os.MkdirAll(filepath.Join(lib, "JSON"), 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(lib, "JSON", "PP.pm"), []byte("package JSON::PP;\nour $VERSION = '98';\n1;\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	upgrades, err := run([]string{lib}, "", index, "", true, perl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(upgrades) != 1 || upgrades[0].Path != "I/IS/ISHIGAKI/JSON-PP-99.tar.gz" {
+		t.Errorf("local::lib: got %+v", upgrades)
+	}
+
+	if _, err = run(nil, list, index, "", true, "4.0"); err == nil {
+		t.Error("unknown perl accepted")
+	}
+}
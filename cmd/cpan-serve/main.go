@@ -0,0 +1,35 @@
+// Command cpan-serve serves a local directory laid out as a CPAN mirror over
+// HTTP, for use with cpanm --mirror or cpm --resolver.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/dolmen-go/CPAN/server"
+)
+
+func main() {
+	listen := flag.String("listen", ":8080", "listen `address`")
+	root := flag.String("root", ".", "root `directory` of the CPAN tree")
+	generate := flag.Bool("generate", false, "generate 02packages and CHECKSUMS when missing")
+	indexTTL := flag.Duration("index-ttl", server.DefaultIndexTTL, "`delay` before scanning the tree again for a generated 02packages")
+	flag.Parse()
+
+	if fi, err := os.Stat(*root); err != nil || !fi.IsDir() {
+		fmt.Fprintf(os.Stderr, "%s: not a directory\n", *root)
+		os.Exit(1)
+	}
+
+	h := &server.Handler{
+		Root:              *root,
+		GenerateIndex:     *generate,
+		GenerateCheckSums: *generate,
+		IndexTTL:          *indexTTL,
+	}
+	log.Printf("Serving %s on %s", *root, *listen)
+	log.Fatal(http.ListenAndServe(*listen, h))
+}
@@ -0,0 +1,111 @@
+// Command cpan-whichfile finds which CPAN upload a local file is, by its
+// sha256 (or md5), using an index of all the CHECKSUMS files of authors/id.
+//
+//	cpan-whichfile -db index.db -import /srv/cpan/authors/id
+//	cpan-whichfile -db index.db Foo-Bar-1.02.tar.gz...
+//
+// The PAUSE signature of the imported CHECKSUMS files is verified, unless
+// -verify=false: an unsigned import trusts whoever wrote the tree.
+//
+// Files whose name matches an upload with another content are flagged. The
+// exit code is 1 if a file is not found or is flagged.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dolmen-go/CPAN"
+	"github.com/dolmen-go/CPAN/indexdb"
+)
+
+func main() {
+	dbFile := flag.String("db", "cpan-index.db", "index database `file`")
+	importDir := flag.String("import", "", "import the CHECKSUMS files of the authors/id `directory` first")
+	verify := flag.Bool("verify", true, "verify the PAUSE signature of imported CHECKSUMS (disable only for a trusted tree, such as a DarkPAN)")
+	asJSON := flag.Bool("json", false, "JSON output")
+	flag.Parse()
+
+	if flag.NArg() == 0 && *importDir == "" {
+		fmt.Fprintln(os.Stderr, "usage: cpan-whichfile [-db index.db] [-import authors/id [-verify=false]] [-json] file...")
+		os.Exit(2)
+	}
+
+	os.Exit(run(*dbFile, *importDir, *verify, *asJSON, flag.Args()))
+}
+
+// run imports importDir, if not empty, then looks up the files. It returns
+// the exit code.
+func run(dbFile, importDir string, verify, asJSON bool, files []string) int {
+	db, err := indexdb.Open(dbFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer db.Close()
+	ctx := context.Background()
+
+	if importDir != "" {
+		if verify {
+			db.KeyRing = CPAN.PAUSEKeyRing
+		}
+		n, err := db.ImportCheckSumsTree(ctx, importDir)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "%d directories updated\n", n)
+	}
+
+	status := 0
+	for _, name := range files {
+		l, err := lookup(ctx, db, name)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			status = 1
+			continue
+		}
+		if len(l.Matches) == 0 || len(l.Mismatches) > 0 {
+			status = 1
+		}
+		if asJSON {
+			buf, _ := json.Marshal(struct {
+				File string `json:"file"`
+				*indexdb.FileLookup
+			}{name, l})
+			fmt.Printf("%s\n", buf)
+			continue
+		}
+		if len(l.Matches) == 0 {
+			fmt.Printf("%s: not found\n", name)
+		}
+		for _, f := range l.Matches {
+			kind := "sha256"
+			if l.MD5Only {
+				kind = "md5 only"
+			}
+			fmt.Printf("%s: %s (%s, %d bytes, %s)\n", name, f.Path, kind, f.Size, f.MTime)
+		}
+		for _, f := range l.Mismatches {
+			fmt.Printf("%s: MISMATCH with %s (sha256 %s, %d bytes)\n", name, f.Path, f.Sha256, f.Size)
+		}
+	}
+	return status
+}
+
+func lookup(ctx context.Context, db *indexdb.DB, name string) (*indexdb.FileLookup, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	sum, err := CPAN.ComputeCheckSum(f)
+	f.Close()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", name, err)
+	}
+	return db.LookupFile(ctx, filepath.Base(name), sum)
+}
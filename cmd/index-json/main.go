@@ -1,42 +1,308 @@
-// An example of CPAN.ReadIndex(): converts a 02packages.tar.gz to JSON.
+// Command index-json converts a 02packages.details.txt.gz file to JSON,
+// NDJSON, CSV, TSV or SQLite.
+//
+//	index-json [-format json|ndjson|csv|tsv|sqlite] [-o output] [-header] [-package regexp] [-author regexp] [file|URL|-]
+//
+// The index is read from stdin if no file is given, or from "-".
+//
+// With -header, the header of the index is included as metadata: as a
+// "header" object in JSON and NDJSON, in the "header" table in SQLite. The
+// CSV and TSV outputs have only the entries, to remain readable by any CSV
+// reader: the header is written to stderr as "# Key: value" lines.
+//
+// The sqlite format uses modernc.org/sqlite, a database/sql driver in pure
+// Go (no cgo), to fetch before building:
+//
+//	go get modernc.org/sqlite
 package main
 
 import (
+	"bufio"
+	"encoding/csv"
 	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/dolmen-go/CPAN"
 )
 
+// writer writes the header then the entries of the index.
+//
+// Close is given the error of the conversion, if any: the output is then
+// incomplete and is discarded where possible.
+type writer interface {
+	Header(h map[string]string) error
+	Entry(e *CPAN.PackagesIndexEntry) error
+	Close(err error) error
+}
+
 func main() {
-	f, err := os.Open(os.Args[1])
-	if err != nil {
+	format := flag.String("format", "json", "output `format`: json, ndjson, csv, tsv or sqlite")
+	output := flag.String("o", "", "output `file` (required for sqlite, default stdout)")
+	withHeader := flag.Bool("header", false, "include the header of the index (always included for sqlite, written to stderr for csv and tsv)")
+	pkgPattern := flag.String("package", "", "only packages matching `regexp`")
+	authorPattern := flag.String("author", "", "only packages of authors (PAUSE ID) matching `regexp`")
+	flag.Parse()
+
+	if flag.NArg() > 1 {
+		fmt.Fprintln(os.Stderr, "usage: index-json [options] [02packages.details.txt.gz|URL|-]")
+		os.Exit(2)
+	}
+	if err := run(*format, *output, *withHeader, *pkgPattern, *authorPattern, flag.Arg(0)); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
+}
 
-	defer f.Close()
+func run(format, output string, withHeader bool, pkgPattern, authorPattern, input string) error {
+	var pkgRe, authorRe *regexp.Regexp
+	var err error
+	if pkgPattern != "" {
+		if pkgRe, err = regexp.Compile(pkgPattern); err != nil {
+			return fmt.Errorf("-package: %s", err)
+		}
+	}
+	if authorPattern != "" {
+		if authorRe, err = regexp.Compile(authorPattern); err != nil {
+			return fmt.Errorf("-author: %s", err)
+		}
+	}
 
-	_, entries, done := CPAN.ReadPackagesIndex(f)
+	in, err := open(input)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
 
-	sep := "[\n"
-LOOP:
-	for {
-		select {
-		case entry := <-entries:
-			os.Stdout.WriteString(sep)
-			sep = ",\n"
-			buf, _ := json.Marshal(entry)
-			os.Stdout.Write(buf)
-		case err = <-done:
-			if err != nil {
-				fmt.Fprintln(os.Stderr, err)
+	var w writer
+	switch format {
+	case "sqlite":
+		if output == "" {
+			return fmt.Errorf("-o is required for the sqlite format")
+		}
+		if w, err = newSQLiteWriter(output); err != nil {
+			return err
+		}
+		withHeader = true
+	case "json", "ndjson", "csv", "tsv":
+		f := os.Stdout
+		if output != "" {
+			if f, err = os.Create(output); err != nil {
+				return err
+			}
+		}
+		out := &bufferedFile{bufio.NewWriter(f), f}
+		switch format {
+		case "json":
+			w = &jsonWriter{out: out}
+		case "ndjson":
+			w = &ndjsonWriter{out: out}
+		default:
+			cw := csv.NewWriter(out)
+			if format == "tsv" {
+				cw.Comma = '\t'
 			}
-			break LOOP
+			w = &csvWriter{out: out, w: cw, meta: os.Stderr}
+		}
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+
+	header, entries, done := CPAN.ReadPackagesIndex(in)
+	if withHeader && header != nil {
+		h := make(map[string]string, len(header))
+		for k, v := range header {
+			h[k] = strings.Join(v, ", ")
+		}
+		err = w.Header(h)
+	}
+	for e := range entries {
+		if err != nil {
+			// Drain to let the reader complete
+			continue
+		}
+		if pkgRe != nil && !pkgRe.MatchString(e.Package) {
+			continue
+		}
+		if authorRe != nil && !authorRe.MatchString(e.Author()) {
+			continue
+		}
+		err = w.Entry(e)
+	}
+	if e := <-done; e != nil && err == nil {
+		err = fmt.Errorf("%s: %s", inputName(input), e)
+	}
+	if e := w.Close(err); e != nil && err == nil {
+		err = e
+	}
+	return err
+}
+
+func inputName(input string) string {
+	if input == "" || input == "-" {
+		return "stdin"
+	}
+	return input
+}
+
+// open opens a file, an URL or stdin.
+func open(input string) (io.ReadCloser, error) {
+	switch {
+	case input == "" || input == "-":
+		return os.Stdin, nil
+	case strings.HasPrefix(input, "http://") || strings.HasPrefix(input, "https://"):
+		resp, err := http.Get(input)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("%s: %s", input, resp.Status)
+		}
+		return resp.Body, nil
+	default:
+		return os.Open(input)
+	}
+}
+
+// bufferedFile flushes the buffer when closed.
+type bufferedFile struct {
+	*bufio.Writer
+	f *os.File
+}
+
+func (b *bufferedFile) Close() error {
+	err := b.Flush()
+	if e := b.f.Close(); err == nil {
+		err = e
+	}
+	return err
+}
+
+func sortedKeys(h map[string]string) []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// jsonWriter writes a JSON array of entries, or an object with the header
+// and the array of entries as "packages".
+type jsonWriter struct {
+	out    io.WriteCloser
+	header bool
+	sep    string
+}
+
+func (w *jsonWriter) Header(h map[string]string) error {
+	buf, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+	w.header = true
+	_, err = fmt.Fprintf(w.out, "{\"header\":%s,\n\"packages\":", buf)
+	return err
+}
+
+func (w *jsonWriter) Entry(e *CPAN.PackagesIndexEntry) error {
+	if w.sep == "" {
+		w.sep = "[\n"
+	}
+	buf, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err = io.WriteString(w.out, w.sep); err != nil {
+		return err
+	}
+	w.sep = ",\n"
+	_, err = w.out.Write(buf)
+	return err
+}
+
+func (w *jsonWriter) Close(error) error {
+	end := "\n]"
+	if w.sep == "" {
+		end = "[]"
+	}
+	if w.header {
+		end += "}"
+	}
+	_, err := io.WriteString(w.out, end+"\n")
+	if e := w.out.Close(); err == nil {
+		err = e
+	}
+	return err
+}
+
+// ndjsonWriter writes one JSON object per line. The header, if any, is the
+// first line: {"header":{...}}.
+type ndjsonWriter struct {
+	out io.WriteCloser
+}
+
+func (w *ndjsonWriter) Header(h map[string]string) error {
+	return json.NewEncoder(w.out).Encode(struct {
+		Header map[string]string `json:"header"`
+	}{h})
+}
+
+func (w *ndjsonWriter) Entry(e *CPAN.PackagesIndexEntry) error {
+	return json.NewEncoder(w.out).Encode(e)
+}
+
+func (w *ndjsonWriter) Close(error) error {
+	return w.out.Close()
+}
+
+// csvWriter writes a line with the column names, followed by the entries.
+// The header, if any, goes to meta as "# Key: value" lines: comment lines
+// are not part of the CSV format.
+type csvWriter struct {
+	out     io.WriteCloser
+	w       *csv.Writer
+	meta    io.Writer
+	started bool
+}
+
+func (w *csvWriter) Header(h map[string]string) error {
+	for _, k := range sortedKeys(h) {
+		if _, err := fmt.Fprintf(w.meta, "# %s: %s\n", k, h[k]); err != nil {
+			return err
 		}
 	}
-	if sep[0] != '[' {
-		os.Stdout.Write([]byte{']', '\n'})
+	return nil
+}
+
+func (w *csvWriter) start() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+	return w.w.Write([]string{"package", "version", "path"})
+}
+
+func (w *csvWriter) Entry(e *CPAN.PackagesIndexEntry) error {
+	if err := w.start(); err != nil {
+		return err
+	}
+	return w.w.Write([]string{e.Package, e.Version, e.Path})
+}
+
+func (w *csvWriter) Close(error) error {
+	w.start()
+	w.w.Flush()
+	err := w.w.Error()
+	if e := w.out.Close(); err == nil {
+		err = e
 	}
+	return err
 }
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+var testEntries = []*CPAN.PackagesIndexEntry{
+	{Package: "Acme::Foo", Version: "1.0", Path: "D/DO/DOLMEN/Acme-Foo-1.0.tar.gz"},
+	{Package: "Foo::Bar", Version: "1.02", Path: "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz"},
+	{Package: "Foo::Bar::Baz", Version: "undef", Path: "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz"},
+	{Package: "Other", Version: "0.01", Path: "O/OT/OTHER/Other-0.01.tar.gz"},
+}
+
+// writeTestIndex writes a 02packages.details.txt.gz file with testEntries
+// into dir.
+func writeTestIndex(t *testing.T, dir string) string {
+	var buf bytes.Buffer
+	if err := CPAN.WritePackagesIndex(&buf, map[string][]string{"Last-Updated": {"Sat, 26 Nov 2016 16:52:43 GMT"}}, testEntries); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "02packages.details.txt.gz")
+	if err := ioutil.WriteFile(file, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "index-json-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	input := writeTestIndex(t, dir)
+	output := filepath.Join(dir, "out")
+
+	convert := func(format string, withHeader bool, pkgPattern, authorPattern string) []byte {
+		t.Helper()
+		os.Remove(output)
+		if err := run(format, output, withHeader, pkgPattern, authorPattern, input); err != nil {
+			t.Fatalf("%s: %s", format, err)
+		}
+		out, err := ioutil.ReadFile(output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+
+	var entries []*CPAN.PackagesIndexEntry
+	if err = json.Unmarshal(convert("json", false, "", ""), &entries); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(entries, testEntries) {
+		t.Errorf("json: got %+v", entries)
+	}
+
+	var withHeader struct {
+		Header   map[string]string          `json:"header"`
+		Packages []*CPAN.PackagesIndexEntry `json:"packages"`
+	}
+	if err = json.Unmarshal(convert("json", true, "^Foo::", "DOLMEN"), &withHeader); err != nil {
+		t.Fatal(err)
+	}
+	if withHeader.Header["Line-Count"] != "4" || len(withHeader.Packages) != 2 {
+		t.Errorf("json -header: got %+v", withHeader)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(convert("ndjson", true, "", "^OTHER$"))), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], `{"header":{`) || !strings.Contains(lines[1], `"package":"Other"`) {
+		t.Errorf("ndjson: got %q", lines)
+	}
+
+	for format, comma := range map[string]rune{"csv": ',', "tsv": '\t'} {
+		r := csv.NewReader(bytes.NewReader(convert(format, false, "", "")))
+		r.Comma = comma
+		records, err := r.ReadAll()
+		if err != nil {
+			t.Fatalf("%s: %s", format, err)
+		}
+		if len(records) != 5 || !reflect.DeepEqual(records[0], []string{"package", "version", "path"}) ||
+			!reflect.DeepEqual(records[4], []string{"Other", "0.01", "O/OT/OTHER/Other-0.01.tar.gz"}) {
+			t.Errorf("%s: got %q", format, records)
+		}
+	}
+
+	if err = run("xml", output, false, "", "", input); err == nil {
+		t.Error("unknown format accepted")
+	}
+	if err = run("json", output, false, "(", "", input); err == nil {
+		t.Error("invalid -package accepted")
+	}
+	if err = ioutil.WriteFile(input, []byte("not gzipped"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = run("json", output, false, "", "", input); err == nil {
+		t.Error("invalid input accepted")
+	}
+}
+
+// The header is not written to the CSV output
+func TestCSVWriterHeader(t *testing.T) {
+	var out, meta bytes.Buffer
+	w := &csvWriter{out: nopCloser{&out}, w: csv.NewWriter(&out), meta: &meta}
+	if err := w.Header(map[string]string{"File": "02packages.details.txt", "Line-Count": "1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Entry(testEntries[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := meta.String(); got != "# File: 02packages.details.txt\n# Line-Count: 1\n" {
+		t.Errorf("header: got %q", got)
+	}
+	if got := out.String(); got != "package,version,path\nAcme::Foo,1.0,D/DO/DOLMEN/Acme-Foo-1.0.tar.gz\n" {
+		t.Errorf("output: got %q", got)
+	}
+}
+
+type nopCloser struct {
+	*bytes.Buffer
+}
+
+func (nopCloser) Close() error { return nil }
+
+func TestSQLite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "index-json-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	input := writeTestIndex(t, dir)
+	output := filepath.Join(dir, "index.db")
+
+	// Twice: the tables are replaced
+	for i := 0; i < 2; i++ {
+		if err = run("sqlite", output, false, "", "DOLMEN", input); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	db, err := sql.Open("sqlite", output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	var n int
+	if err = db.QueryRow(`SELECT COUNT(*) FROM packages WHERE author = 'DOLMEN'`).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("packages: got %d", n)
+	}
+	var version string
+	if err = db.QueryRow(`SELECT version FROM packages WHERE package = 'Foo::Bar'`).Scan(&version); err != nil {
+		t.Fatal(err)
+	}
+	if version != "1.02" {
+		t.Errorf("Foo::Bar: got %q", version)
+	}
+	var lineCount string
+	if err = db.QueryRow(`SELECT value FROM header WHERE name = 'Line-Count'`).Scan(&lineCount); err != nil {
+		t.Fatal(err)
+	}
+	if lineCount != "4" {
+		t.Errorf("Line-Count: got %q", lineCount)
+	}
+
+	// A truncated index is not saved: the previous content is kept
+	data, err := ioutil.ReadFile(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	truncated := filepath.Join(dir, "truncated.gz")
+	if err = ioutil.WriteFile(truncated, data[:len(data)-20], 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = run("sqlite", output, false, "^Acme::", "", truncated); err == nil {
+		t.Fatal("truncated index accepted")
+	}
+	if err = db.QueryRow(`SELECT COUNT(*) FROM packages`).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("packages after a failure: got %d", n)
+	}
+
+	if err = run("sqlite", "", false, "", "", input); err == nil {
+		t.Error("sqlite without -o accepted")
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"database/sql"
+
+	"github.com/dolmen-go/CPAN"
+
+	_ "modernc.org/sqlite"
+)
+
+const sqliteSchema = `
+DROP TABLE IF EXISTS header;
+DROP TABLE IF EXISTS packages;
+CREATE TABLE header (
+	name  TEXT PRIMARY KEY,
+	value TEXT NOT NULL
+);
+CREATE TABLE packages (
+	package TEXT PRIMARY KEY,
+	version TEXT NOT NULL,
+	path    TEXT NOT NULL,
+	author  TEXT NOT NULL
+);
+`
+
+// sqliteWriter writes the header and the entries in the tables "header" and
+// "packages" of an SQLite database, in a single transaction.
+type sqliteWriter struct {
+	db   *sql.DB
+	tx   *sql.Tx
+	stmt *sql.Stmt
+}
+
+func newSQLiteWriter(file string) (*sqliteWriter, error) {
+	db, err := sql.Open("sqlite", file)
+	if err != nil {
+		return nil, err
+	}
+	w := &sqliteWriter{db: db}
+	if w.tx, err = db.Begin(); err == nil {
+		if _, err = w.tx.Exec(sqliteSchema); err == nil {
+			w.stmt, err = w.tx.Prepare(`INSERT INTO packages (package, version, path, author) VALUES (?, ?, ?, ?)`)
+		}
+	}
+	if err != nil {
+		if w.tx != nil {
+			w.tx.Rollback()
+		}
+		db.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *sqliteWriter) Header(h map[string]string) error {
+	for _, k := range sortedKeys(h) {
+		if _, err := w.tx.Exec(`INSERT INTO header (name, value) VALUES (?, ?)`, k, h[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *sqliteWriter) Entry(e *CPAN.PackagesIndexEntry) error {
+	_, err := w.stmt.Exec(e.Package, e.Version, e.Path, e.Author())
+	return err
+}
+
+// Close commits the transaction, unless the conversion failed: a truncated
+// index is not saved.
+func (w *sqliteWriter) Close(failed error) error {
+	w.stmt.Close()
+	var err error
+	if failed != nil {
+		err = w.tx.Rollback()
+	} else {
+		err = w.tx.Commit()
+	}
+	if e := w.db.Close(); err == nil {
+		err = e
+	}
+	return err
+}
@@ -0,0 +1,228 @@
+//go:generate go run -tags generate corelist_gen.go
+
+// Package corelist tells which modules are distributed with each release of
+// perl, from the data of Module::CoreList.
+//
+// Perl versions may be given in any form: "5.036000", "5.036", "5.36.0" or
+// "v5.36".
+//
+// To update the data, install the latest Module::CoreList, then:
+//
+//	perl testdata/dump.pl > testdata/corelist.json
+//	go generate
+package corelist
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+// undef is the version of modules that have no $VERSION.
+const undef = ""
+
+// release is the difference between a perl release and the release given
+// by from.
+type release struct {
+	perl       string
+	date       string
+	from       int
+	changed    map[string]string
+	removed    []string
+	deprecated []string
+}
+
+var (
+	modulesOnce sync.Once
+	// modules of each release, by index in releases
+	modules []map[string]string
+)
+
+func releaseModules(i int) map[string]string {
+	modulesOnce.Do(func() {
+		modules = make([]map[string]string, len(releases))
+		for i, r := range releases {
+			var m map[string]string
+			if r.from < 0 {
+				m = make(map[string]string, len(r.changed))
+			} else {
+				prev := modules[r.from]
+				m = make(map[string]string, len(prev)+len(r.changed))
+				for module, version := range prev {
+					m[module] = version
+				}
+			}
+			for module, version := range r.changed {
+				m[module] = version
+			}
+			for _, module := range r.removed {
+				delete(m, module)
+			}
+			modules[i] = m
+		}
+	})
+	return modules[i]
+}
+
+// normalize returns the perl version in the form of Module::CoreList:
+// "5.036000".
+func normalize(perl string) (string, error) {
+	v, err := CPAN.ParseVersion(perl)
+	if err != nil {
+		return "", fmt.Errorf("perl %q: %s", perl, err)
+	}
+	for len(v) < 3 {
+		v = append(v, 0)
+	}
+	return fmt.Sprintf("%d.%03d%03d", v[0], v[1], v[2]), nil
+}
+
+// find returns the index of the release of perl in releases.
+func find(perl string) (int, error) {
+	p, err := normalize(perl)
+	if err != nil {
+		return -1, err
+	}
+	i := sort.Search(len(releases), func(i int) bool {
+		return releases[i].perl >= p
+	})
+	if i == len(releases) || releases[i].perl != p {
+		return -1, fmt.Errorf("perl %s: unknown release", perl)
+	}
+	return i, nil
+}
+
+// Releases returns the perl releases known, in version order, including
+// development releases.
+func Releases() []string {
+	list := make([]string, len(releases))
+	for i, r := range releases {
+		list[i] = r.perl
+	}
+	return list
+}
+
+// IsKnownRelease reports whether perl is a release of the data.
+func IsKnownRelease(perl string) bool {
+	_, err := find(perl)
+	return err == nil
+}
+
+// ReleaseDate returns the date of the release of perl, such as
+// "2022-05-27".
+func ReleaseDate(perl string) (string, error) {
+	i, err := find(perl)
+	if err != nil {
+		return "", err
+	}
+	return releases[i].date, nil
+}
+
+// Modules returns the modules distributed with perl and their version.
+// The version is empty for modules without $VERSION.
+// The map must not be modified.
+func Modules(perl string) (map[string]string, error) {
+	i, err := find(perl)
+	if err != nil {
+		return nil, err
+	}
+	return releaseModules(i), nil
+}
+
+// ModuleVersion returns the version of module distributed with perl.
+// ok is false if the module is not distributed with perl or if perl is not
+// a known release.
+func ModuleVersion(module, perl string) (version string, ok bool) {
+	i, err := find(perl)
+	if err != nil {
+		return "", false
+	}
+	version, ok = releaseModules(i)[module]
+	return
+}
+
+// IsCore reports whether module is distributed with perl with at least the
+// given version. An empty version, or "0", accepts any version.
+func IsCore(module, version, perl string) bool {
+	v, ok := ModuleVersion(module, perl)
+	if !ok {
+		return false
+	}
+	if version == "" || version == "0" {
+		return true
+	}
+	return v != undef && CPAN.CompareVersions(v, version) >= 0
+}
+
+// FirstRelease returns the first release of perl that includes module, or
+// the empty string if the module has never been in core.
+func FirstRelease(module string) string {
+	for i, r := range releases {
+		if _, ok := r.changed[module]; ok {
+			if _, ok = releaseModules(i)[module]; ok {
+				return r.perl
+			}
+		}
+	}
+	return ""
+}
+
+// RemovedFrom returns the first release of perl that does not include module
+// anymore after FirstRelease, or the empty string if the module is still
+// in core in the latest release.
+func RemovedFrom(module string) string {
+	first := FirstRelease(module)
+	if first == "" {
+		return ""
+	}
+	// Maintenance releases of an old branch may come after the first
+	// development release of the next branch
+	if _, ok := releaseModules(len(releases) - 1)[module]; ok {
+		return ""
+	}
+	for i, r := range releases {
+		if r.perl <= first {
+			continue
+		}
+		if _, ok := releaseModules(i)[module]; !ok {
+			return r.perl
+		}
+	}
+	return ""
+}
+
+// IsDeprecated reports whether module is deprecated in perl.
+func IsDeprecated(module, perl string) bool {
+	i, err := find(perl)
+	if err != nil {
+		return false
+	}
+	for _, m := range releases[i].deprecated {
+		if m == module {
+			return true
+		}
+	}
+	return false
+}
+
+// Core returns a function that reports whether a module is distributed with
+// perl in a version that satisfies a range, for use as resolver.Resolver.Core.
+// Deprecated modules are not considered as core.
+func Core(perl string) (func(module string, r CPAN.VersionRange) bool, error) {
+	i, err := find(perl)
+	if err != nil {
+		return nil, err
+	}
+	return func(module string, r CPAN.VersionRange) bool {
+		v, ok := releaseModules(i)[module]
+		if !ok || IsDeprecated(module, perl) {
+			return false
+		}
+		if v == undef {
+			return len(r) == 0
+		}
+		return r.AcceptsString(v)
+	}, nil
+}
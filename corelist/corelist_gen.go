@@ -0,0 +1,113 @@
+// +build generate
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/template"
+
+	"github.com/dolmen-go/codegen"
+)
+
+// dump is the output of testdata/dump.pl
+type dump struct {
+	Version  string `json:"version"`
+	Releases []struct {
+		Perl       string             `json:"perl"`
+		Date       string             `json:"date"`
+		DeltaFrom  *string            `json:"delta_from"`
+		Changed    map[string]*string `json:"changed"`
+		Removed    []string           `json:"removed"`
+		Deprecated []string           `json:"deprecated"`
+
+		// Index of DeltaFrom in Releases, -1 for the first release
+		From int `json:"-"`
+	} `json:"releases"`
+}
+
+const tmpl = `// Code generated by go run -tags generate corelist_gen.go # DO NOT EDIT.
+
+package corelist
+
+// DataVersion is the version of Module::CoreList the data comes from.
+const DataVersion = {{printf "%q" .Version}}
+
+var releases = []release{
+{{- range .Releases}}
+	{
+		perl: {{printf "%q" .Perl}},
+		date: {{printf "%q" .Date}},
+		from: {{.From}},
+		{{- if .Changed}}
+		changed: map[string]string{
+			{{- range $module, $version := .Changed}}
+			{{printf "%q" $module}}: {{version $version}},
+			{{- end}}
+		},
+		{{- end}}
+		{{- if .Removed}}
+		removed: []string{
+			{{- range .Removed}}
+			{{printf "%q" .}},
+			{{- end}}
+		},
+		{{- end}}
+		{{- if .Deprecated}}
+		deprecated: []string{
+			{{- range .Deprecated}}
+			{{printf "%q" .}},
+			{{- end}}
+		},
+		{{- end}}
+	},
+{{- end}}
+}
+`
+
+func main() {
+	f, err := os.Open("testdata/corelist.json")
+	if err != nil {
+		fmt.Fprint(os.Stderr, err)
+		os.Exit(1)
+	}
+	var data dump
+	err = json.NewDecoder(f).Decode(&data)
+	f.Close()
+	if err != nil {
+		fmt.Fprint(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	index := make(map[string]int, len(data.Releases))
+	for i := range data.Releases {
+		r := &data.Releases[i]
+		r.From = -1
+		if r.DeltaFrom != nil {
+			from, ok := index[*r.DeltaFrom]
+			if !ok {
+				fmt.Fprintf(os.Stderr, "%s: delta from unknown or later release %s", r.Perl, *r.DeltaFrom)
+				os.Exit(1)
+			}
+			r.From = from
+		}
+		index[r.Perl] = i
+	}
+
+	t := &codegen.CodeTemplate{
+		Template: template.Must(template.New("").Funcs(template.FuncMap{
+			"version": func(v *string) string {
+				if v == nil {
+					return "undef"
+				}
+				return fmt.Sprintf("%q", *v)
+			},
+		}).Parse(tmpl)),
+	}
+	err = t.CreateFile("corelist_data.go", &data)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,100 @@
+package corelist
+
+import (
+	"testing"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+func TestModuleVersion(t *testing.T) {
+	for _, test := range []struct {
+		module, perl, version string
+		ok                    bool
+	}{
+		{"Scalar::Util", "5.030000", "1.50", true},
+		{"Scalar::Util", "5.36.0", "1.62", true},
+		{"Scalar::Util", "v5.36", "1.62", true},
+		{"Scalar::Util", "5.036", "1.62", true},
+		{"Scalar::Util", "5.006", "", false},
+		{"Moo", "5.036", "", false},
+		{"CGI", "5.020", "3.65", true},
+		{"CGI", "5.022", "", false},
+		{"Scalar::Util", "5.999", "", false},
+	} {
+		v, ok := ModuleVersion(test.module, test.perl)
+		if v != test.version || ok != test.ok {
+			t.Errorf("%s in %s: got %q %v", test.module, test.perl, v, ok)
+		}
+	}
+}
+
+func TestIsCore(t *testing.T) {
+	for _, test := range []struct {
+		module, version, perl string
+		core                  bool
+	}{
+		{"Scalar::Util", "1.50", "5.30.0", true},
+		{"Scalar::Util", "1.55", "5.30.0", false},
+		{"Scalar::Util", "0", "5.30.0", true},
+		{"JSON::PP", "", "5.012", false},
+		{"JSON::PP", "", "5.014", true},
+		{"Moo", "", "5.036", false},
+	} {
+		if got := IsCore(test.module, test.version, test.perl); got != test.core {
+			t.Errorf("IsCore(%q, %q, %q): got %v", test.module, test.version, test.perl, got)
+		}
+	}
+}
+
+func TestFirstRelease(t *testing.T) {
+	for module, expected := range map[string]string{
+		"Scalar::Util": "5.007003",
+		"JSON::PP":     "5.013009",
+		"strict":       "5.000000",
+		"Moo":          "",
+	} {
+		if got := FirstRelease(module); got != expected {
+			t.Errorf("FirstRelease(%q): got %q, expected %q", module, got, expected)
+		}
+	}
+	if got := RemovedFrom("CGI"); got != "5.021000" {
+		t.Errorf("RemovedFrom(CGI): %q", got)
+	}
+	if got := RemovedFrom("Scalar::Util"); got != "" {
+		t.Errorf("RemovedFrom(Scalar::Util): %q", got)
+	}
+}
+
+func TestReleases(t *testing.T) {
+	list := Releases()
+	if len(list) < 200 || list[0] != "5.000000" {
+		t.Errorf("got %d releases from %s", len(list), list[0])
+	}
+	if d, err := ReleaseDate("5.36.0"); err != nil || d != "2022-05-27" {
+		t.Errorf("ReleaseDate: %q %v", d, err)
+	}
+	if _, err := ReleaseDate("5.7.99"); err == nil {
+		t.Error("unknown release: error expected")
+	}
+	if !IsDeprecated("CGI", "5.020") || IsDeprecated("CGI", "5.018") {
+		t.Error("IsDeprecated(CGI)")
+	}
+}
+
+func TestCore(t *testing.T) {
+	core, err := Core("5.30.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rng, _ := CPAN.ParseVersionRange(">= 1.45, < 2")
+	if !core("Scalar::Util", rng) {
+		t.Error("Scalar::Util 1.50 should satisfy the range")
+	}
+	rng, _ = CPAN.ParseVersionRange("1.55")
+	if core("Scalar::Util", rng) || core("Moo", nil) {
+		t.Error("not core expected")
+	}
+	if _, err = Core("4.0"); err == nil {
+		t.Error("error expected")
+	}
+}
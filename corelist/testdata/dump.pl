@@ -0,0 +1,30 @@
+#!/usr/bin/env perl
+# Dumps the data of Module::CoreList as JSON, for corelist_gen.go:
+#
+#     perl testdata/dump.pl > testdata/corelist.json
+use strict;
+use warnings;
+use Module::CoreList;
+use JSON::PP ();
+
+sub norm { sprintf '%.6f', shift }
+
+my %seen;
+my @releases;
+for my $perl (sort { $a <=> $b } keys %Module::CoreList::released) {
+    next if $seen{norm($perl)}++;
+    my $delta = $Module::CoreList::delta{$perl} or next;
+    push @releases, {
+        perl       => norm($perl),
+        date       => $Module::CoreList::released{$perl},
+        delta_from => (defined $delta->{delta_from} ? norm($delta->{delta_from}) : undef),
+        changed    => { %{ $delta->{changed} || {} } },
+        removed    => [ sort keys %{ $delta->{removed} || {} } ],
+        deprecated => [ sort keys %{ $Module::CoreList::deprecated{$perl} || {} } ],
+    };
+}
+
+print JSON::PP->new->canonical->pretty->encode({
+    version  => "$Module::CoreList::VERSION",
+    releases => \@releases,
+});
@@ -0,0 +1,444 @@
+package CPAN
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// Cpanfile is the content of a cpanfile, the dependency declaration file of
+// Module::CPANfile, with the prereqs in the same structure as Meta.
+type Cpanfile struct {
+	Prereqs  Prereqs
+	Features map[string]OptionalFeature
+	// Mirrors are the URLs given with the mirror keyword.
+	Mirrors []string
+	// Options are the options given to requirements, by module: dist, url,
+	// mirror, git, ref...
+	Options map[string]map[string]string
+}
+
+// CpanfileError is a syntax error in a cpanfile.
+type CpanfileError struct {
+	Line int
+	Msg  string
+}
+
+func (e *CpanfileError) Error() string {
+	return fmt.Sprintf("cpanfile:%d: %s", e.Line, e.Msg)
+}
+
+// cpanfileRelationships maps the requirement keywords of cpanfile to a
+// relationship and a phase, if the keyword implies one.
+var cpanfileRelationships = map[string][2]string{
+	"requires":           {"requires", ""},
+	"recommends":         {"recommends", ""},
+	"suggests":           {"suggests", ""},
+	"conflicts":          {"conflicts", ""},
+	"configure_requires": {"requires", "configure"},
+	"build_requires":     {"requires", "build"},
+	"test_requires":      {"requires", "test"},
+	"author_requires":    {"requires", "develop"},
+}
+
+// ReadCpanfile parses the cpanfile read from r.
+//
+// cpanfile is Perl code: only the DSL of Module::CPANfile is supported,
+// with literal arguments. Variables, expressions and conditionals are
+// syntax errors.
+func ReadCpanfile(r io.Reader) (*Cpanfile, error) {
+	src, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	p := &cpanfileParser{lex: cpanfileLexer{src: string(src), line: 1}}
+	stmts, err := p.parseBlock(false)
+	if err != nil {
+		return nil, err
+	}
+	c := &Cpanfile{
+		Prereqs: make(Prereqs),
+		Options: make(map[string]map[string]string),
+	}
+	if err = c.eval(stmts, "runtime", c.Prereqs, false); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Cpanfile) eval(stmts []cpanfileStmt, phase string, prereqs Prereqs, inFeature bool) error {
+	for _, st := range stmts {
+		errorf := func(format string, args ...interface{}) error {
+			return &CpanfileError{Line: st.line, Msg: st.keyword + ": " + fmt.Sprintf(format, args...)}
+		}
+		if rel, ok := cpanfileRelationships[st.keyword]; ok {
+			args, err := st.strings()
+			if err != nil {
+				return errorf("%s", err)
+			}
+			if len(args) == 0 {
+				return errorf("module name expected")
+			}
+			module, args := args[0], args[1:]
+			rng := "0"
+			if len(args)%2 == 1 {
+				rng, args = args[0], args[1:]
+			}
+			if _, err := ParseVersionRange(rng); err != nil {
+				return errorf("%s", err)
+			}
+			ph := phase
+			if rel[1] != "" {
+				ph = rel[1]
+			}
+			prereqs.add(ph, rel[0], Requirements{module: rng})
+			if len(args) > 0 {
+				opts := c.Options[module]
+				if opts == nil {
+					opts = make(map[string]string)
+					c.Options[module] = opts
+				}
+				for i := 0; i < len(args); i += 2 {
+					opts[args[i]] = args[i+1]
+				}
+			}
+			continue
+		}
+
+		switch st.keyword {
+		case "on":
+			if len(st.args) != 2 || st.args[0].block != nil || st.args[1].block == nil {
+				return errorf("expected: on PHASE => sub { ... }")
+			}
+			ph := st.args[0].value
+			if !contains(MetaPhases, ph) {
+				return errorf("invalid phase %q", ph)
+			}
+			if err := c.eval(st.args[1].block, ph, prereqs, inFeature); err != nil {
+				return err
+			}
+		case "feature":
+			if inFeature {
+				return errorf("nested feature")
+			}
+			n := len(st.args)
+			if n < 2 || n > 3 || st.args[n-1].block == nil || st.args[0].block != nil || n == 3 && st.args[1].block != nil {
+				return errorf("expected: feature NAME, DESCRIPTION => sub { ... }")
+			}
+			f := OptionalFeature{Prereqs: make(Prereqs)}
+			if n == 3 {
+				f.Description = st.args[1].value
+			}
+			if err := c.eval(st.args[n-1].block, "runtime", f.Prereqs, true); err != nil {
+				return err
+			}
+			if c.Features == nil {
+				c.Features = make(map[string]OptionalFeature)
+			}
+			c.Features[st.args[0].value] = f
+		case "mirror":
+			args, err := st.strings()
+			if err != nil || len(args) != 1 {
+				return errorf("expected: mirror URL")
+			}
+			c.Mirrors = append(c.Mirrors, args[0])
+		default:
+			return errorf("unsupported keyword")
+		}
+	}
+	return nil
+}
+
+// cpanfileStmt is a call: keyword arg, arg...
+type cpanfileStmt struct {
+	line    int
+	keyword string
+	args    []cpanfileArg
+}
+
+// cpanfileArg is a literal or an anonymous sub.
+type cpanfileArg struct {
+	value string
+	block []cpanfileStmt
+}
+
+func (st *cpanfileStmt) strings() ([]string, error) {
+	s := make([]string, len(st.args))
+	for i, a := range st.args {
+		if a.block != nil {
+			return nil, fmt.Errorf("unexpected sub")
+		}
+		s[i] = a.value
+	}
+	return s, nil
+}
+
+type cpanfileParser struct {
+	lex  cpanfileLexer
+	peek *cpanfileToken
+}
+
+func (p *cpanfileParser) next() (cpanfileToken, error) {
+	if p.peek != nil {
+		t := *p.peek
+		p.peek = nil
+		return t, nil
+	}
+	return p.lex.next()
+}
+
+func (p *cpanfileParser) unread(t cpanfileToken) {
+	p.peek = &t
+}
+
+// parseBlock parses statements until the end of input, or until the
+// closing brace if inSub.
+func (p *cpanfileParser) parseBlock(inSub bool) ([]cpanfileStmt, error) {
+	stmts := []cpanfileStmt{}
+	for {
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case t.kind == tokEOF:
+			if inSub {
+				return nil, &CpanfileError{Line: t.line, Msg: "missing '}'"}
+			}
+			return stmts, nil
+		case t.kind == tokPunct && t.text == "}" && inSub:
+			return stmts, nil
+		case t.kind == tokPunct && t.text == ";":
+			continue
+		case t.kind != tokWord:
+			return nil, &CpanfileError{Line: t.line, Msg: fmt.Sprintf("unexpected %q", t.text)}
+		}
+		st := cpanfileStmt{line: t.line, keyword: t.text}
+		if st.args, err = p.parseArgs(); err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, st)
+	}
+}
+
+// parseArgs parses a list of arguments separated by ',' or '=>', with
+// optional parentheses, up to the end of the statement.
+func (p *cpanfileParser) parseArgs() ([]cpanfileArg, error) {
+	var args []cpanfileArg
+	paren := false
+	if t, err := p.next(); err != nil {
+		return nil, err
+	} else if t.kind == tokPunct && t.text == "(" {
+		paren = true
+	} else {
+		p.unread(t)
+	}
+	expectArg := true
+	for {
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case t.kind == tokPunct && (t.text == "," || t.text == "=>"):
+			if expectArg && len(args) == 0 {
+				return nil, &CpanfileError{Line: t.line, Msg: fmt.Sprintf("unexpected %q", t.text)}
+			}
+			expectArg = true
+			continue
+		case paren && t.kind == tokPunct && t.text == ")":
+			paren = false
+			continue
+		case !paren && (t.kind == tokEOF || t.kind == tokPunct && (t.text == ";" || t.text == "}")):
+			if t.text == "}" || t.kind == tokEOF {
+				p.unread(t)
+			}
+			return args, nil
+		case !expectArg:
+			return nil, &CpanfileError{Line: t.line, Msg: fmt.Sprintf("unexpected %q", t.text)}
+		case t.kind == tokString || t.kind == tokWord:
+			if t.kind == tokWord && t.text == "sub" {
+				brace, err := p.next()
+				if err != nil {
+					return nil, err
+				}
+				if brace.kind != tokPunct || brace.text != "{" {
+					return nil, &CpanfileError{Line: brace.line, Msg: "'{' expected after sub"}
+				}
+				block, err := p.parseBlock(true)
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, cpanfileArg{block: block})
+				// The sub closes the statement if not followed by ';'
+				if !paren {
+					if t, err := p.next(); err != nil {
+						return nil, err
+					} else if t.kind != tokPunct || t.text != ";" {
+						p.unread(t)
+					}
+					return args, nil
+				}
+			} else {
+				args = append(args, cpanfileArg{value: t.text})
+			}
+			expectArg = false
+		default:
+			return nil, &CpanfileError{Line: t.line, Msg: fmt.Sprintf("unexpected %q", t.text)}
+		}
+	}
+}
+
+type cpanfileTokenKind int
+
+const (
+	tokEOF cpanfileTokenKind = iota
+	tokWord
+	tokString
+	tokPunct
+)
+
+type cpanfileToken struct {
+	kind cpanfileTokenKind
+	text string
+	line int
+}
+
+type cpanfileLexer struct {
+	src  string
+	pos  int
+	line int
+}
+
+func (l *cpanfileLexer) errorf(format string, args ...interface{}) error {
+	return &CpanfileError{Line: l.line, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (l *cpanfileLexer) next() (cpanfileToken, error) {
+	// Skip blanks, comments and POD
+skip:
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == '\n':
+			l.line++
+			l.pos++
+			if strings.HasPrefix(l.src[l.pos:], "=") && l.pos+1 < len(l.src) && isASCIILetter(l.src[l.pos+1]) {
+				l.skipPOD()
+			}
+			continue
+		case c == ' ' || c == '\t' || c == '\r':
+			l.pos++
+			continue
+		case c == '#':
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+				l.pos++
+			}
+			continue
+		case l.pos == 0 && c == '=':
+			l.skipPOD()
+			continue
+		}
+		break skip
+	}
+	if l.pos >= len(l.src) || strings.HasPrefix(l.src[l.pos:], "__END__") || strings.HasPrefix(l.src[l.pos:], "__DATA__") {
+		return cpanfileToken{kind: tokEOF, line: l.line}, nil
+	}
+
+	start := l.pos
+	c := l.src[l.pos]
+	switch {
+	case c == '\'' || c == '"':
+		return l.quoted(c)
+	case strings.HasPrefix(l.src[l.pos:], "=>"):
+		l.pos += 2
+		return cpanfileToken{kind: tokPunct, text: "=>", line: l.line}, nil
+	case strings.IndexByte(",;(){}", c) >= 0:
+		l.pos++
+		return cpanfileToken{kind: tokPunct, text: string(c), line: l.line}, nil
+	case c >= '0' && c <= '9' || c == '.' || c == 'v' && l.pos+1 < len(l.src) && l.src[l.pos+1] >= '0' && l.src[l.pos+1] <= '9':
+		// Unquoted version numbers
+		for l.pos < len(l.src) && (isWordChar(l.src[l.pos]) || l.src[l.pos] == '.') {
+			l.pos++
+		}
+		return cpanfileToken{kind: tokString, text: l.src[start:l.pos], line: l.line}, nil
+	case isASCIILetter(c) || c == '_':
+		for l.pos < len(l.src) && (isWordChar(l.src[l.pos]) || l.src[l.pos] == ':' && strings.HasPrefix(l.src[l.pos:], "::")) {
+			if l.src[l.pos] == ':' {
+				l.pos++
+			}
+			l.pos++
+		}
+		word := l.src[start:l.pos]
+		if (word == "q" || word == "qq") && l.pos < len(l.src) {
+			if close := strings.IndexByte("({[</|!", l.src[l.pos]); close >= 0 {
+				return l.quoteLike(")}]>/|!"[close])
+			}
+		}
+		return cpanfileToken{kind: tokWord, text: word, line: l.line}, nil
+	}
+	return cpanfileToken{}, l.errorf("unexpected character %q", c)
+}
+
+func isWordChar(c byte) bool {
+	return isASCIILetter(c) || c == '_' || c >= '0' && c <= '9'
+}
+
+func (l *cpanfileLexer) skipPOD() {
+	for l.pos < len(l.src) {
+		end := strings.IndexByte(l.src[l.pos:], '\n')
+		line := l.src[l.pos:]
+		if end >= 0 {
+			line = line[:end]
+		}
+		if end < 0 {
+			l.pos = len(l.src)
+		} else {
+			l.pos += end + 1
+			l.line++
+		}
+		if strings.HasPrefix(line, "=cut") {
+			return
+		}
+	}
+}
+
+func (l *cpanfileLexer) quoted(quote byte) (cpanfileToken, error) {
+	line := l.line
+	l.pos++
+	var b strings.Builder
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		l.pos++
+		switch {
+		case c == quote:
+			return cpanfileToken{kind: tokString, text: b.String(), line: line}, nil
+		case c == '\\' && l.pos < len(l.src):
+			c = l.src[l.pos]
+			l.pos++
+			if c != quote && c != '\\' {
+				b.WriteByte('\\')
+			}
+		case c == '$' && quote == '"' || c == '@' && quote == '"':
+			return cpanfileToken{}, l.errorf("interpolation is not supported")
+		case c == '\n':
+			l.line++
+		}
+		b.WriteByte(c)
+	}
+	return cpanfileToken{}, &CpanfileError{Line: line, Msg: "unterminated string"}
+}
+
+func (l *cpanfileLexer) quoteLike(close byte) (cpanfileToken, error) {
+	line := l.line
+	l.pos++
+	end := strings.IndexByte(l.src[l.pos:], close)
+	if end < 0 {
+		return cpanfileToken{}, &CpanfileError{Line: line, Msg: "unterminated string"}
+	}
+	text := l.src[l.pos : l.pos+end]
+	l.line += strings.Count(text, "\n")
+	l.pos += end + 1
+	return cpanfileToken{kind: tokString, text: text, line: line}, nil
+}
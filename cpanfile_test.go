@@ -0,0 +1,134 @@
+package CPAN
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadCpanfile(t *testing.T) {
+	c, err := ReadCpanfile(strings.NewReader(`# Dependencies
+mirror 'https://darkpan.example.com/';
+
+requires 'perl', '5.008001';
+requires 'JSON::PP';
+requires "Scalar::Util" => 1.50;
+requires 'Moo', '>= 2.0, < 3';
+recommends 'JSON::XS', '3.0';
+conflicts 'Foo::Old', '< 1.0';
+requires 'My::Private', '1.2',
+    dist => 'DOLMEN/My-Private-1.2.tar.gz',
+    mirror => 'https://darkpan.example.com/';
+requires 'Git::Thing', git => 'https://github.com/dolmen/p5-Git-Thing.git', ref => 'master';
+requires('Paren::Module', '0.1');
+
+=pod
+
+requires 'Not::A::Requirement';
+
+=cut
+
+on configure => sub {
+    requires 'ExtUtils::MakeMaker', '6.30';
+};
+
+on 'test' => sub {
+    requires 'Test::More', '0.88';
+    suggests 'Test::Pod';
+};
+test_requires 'Test::Deep';
+author_requires 'Perl::Critic';
+
+feature 'sqlite', 'SQLite support' => sub {
+    requires 'DBD::SQLite', v1.40.0;
+    on test => sub {
+        requires 'Test::DBI'
+    }
+};
+
+__END__
+requires 'After::End';
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Prereqs{
+		"runtime": {
+			"requires": {
+				"perl":          "5.008001",
+				"JSON::PP":      "0",
+				"Scalar::Util":  "1.50",
+				"Moo":           ">= 2.0, < 3",
+				"My::Private":   "1.2",
+				"Git::Thing":    "0",
+				"Paren::Module": "0.1",
+			},
+			"recommends": {"JSON::XS": "3.0"},
+			"conflicts":  {"Foo::Old": "< 1.0"},
+		},
+		"configure": {"requires": {"ExtUtils::MakeMaker": "6.30"}},
+		"test": {
+			"requires": {"Test::More": "0.88", "Test::Deep": "0"},
+			"suggests": {"Test::Pod": "0"},
+		},
+		"develop": {"requires": {"Perl::Critic": "0"}},
+	}
+	if !reflect.DeepEqual(c.Prereqs, expected) {
+		t.Errorf("got %v\nexpected %v", c.Prereqs, expected)
+	}
+
+	f, ok := c.Features["sqlite"]
+	if !ok || f.Description != "SQLite support" {
+		t.Fatalf("features: %+v", c.Features)
+	}
+	if !reflect.DeepEqual(f.Prereqs, Prereqs{
+		"runtime": {"requires": {"DBD::SQLite": "v1.40.0"}},
+		"test":    {"requires": {"Test::DBI": "0"}},
+	}) {
+		t.Errorf("feature prereqs: %v", f.Prereqs)
+	}
+
+	if !reflect.DeepEqual(c.Mirrors, []string{"https://darkpan.example.com/"}) {
+		t.Errorf("mirrors: %q", c.Mirrors)
+	}
+	if !reflect.DeepEqual(c.Options, map[string]map[string]string{
+		"My::Private": {"dist": "DOLMEN/My-Private-1.2.tar.gz", "mirror": "https://darkpan.example.com/"},
+		"Git::Thing":  {"git": "https://github.com/dolmen/p5-Git-Thing.git", "ref": "master"},
+	}) {
+		t.Errorf("options: %v", c.Options)
+	}
+
+	// Same structure as META
+	if got := c.Prereqs.Merge("requires", "runtime", "test")["Test::More"]; got != "0.88" {
+		t.Errorf("Merge: %q", got)
+	}
+}
+
+func TestReadCpanfileErrors(t *testing.T) {
+	for _, test := range []struct {
+		src  string
+		line int
+	}{
+		{"requires 'Foo';\nrequires $module;\n", 2},
+		{"requires 'Foo', \"$v\";", 1},
+		{"on 'install' => sub { requires 'Foo' };", 1},
+		{"\n\non test => sub {\n requires 'Foo';\n", 5},
+		{"feature 'a' => sub { feature 'b' => sub {} };", 1},
+		{"requires 'Foo', '>= junk';", 1},
+		{"requires 'Foo', '_';", 1},
+		{"if ($^O eq 'MSWin32') { requires 'Win32' }", 1},
+		{"requires 'Foo\n", 1},
+		{"osname 'MSWin32';", 1},
+	} {
+		_, err := ReadCpanfile(strings.NewReader(test.src))
+		e, ok := err.(*CpanfileError)
+		if !ok {
+			t.Errorf("%q: got %v", test.src, err)
+			continue
+		}
+		if e.Line != test.line {
+			t.Errorf("%q: got line %d (%s), expected %d", test.src, e.Line, e, test.line)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Package darkpan manages a private CPAN repository (a "DarkPAN"), like
+// OrePAN2: distributions are injected under authors/id and the indexes
+// (02packages and CHECKSUMS) are regenerated.
+package darkpan
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+var reAuthor = regexp.MustCompile(`^[A-Z][A-Z0-9-]{1,8}$`)
+
+// Repo is a CPAN repository on the local filesystem.
+type Repo struct {
+	// Root is the directory that contains authors/id and modules.
+	Root string
+	// Logf, if not nil, reports actions.
+	Logf func(format string, args ...interface{})
+}
+
+func (r *Repo) logf(format string, args ...interface{}) {
+	if r.Logf != nil {
+		r.Logf(format, args...)
+	}
+}
+
+func (r *Repo) localPath(p string) string {
+	return filepath.Join(r.Root, filepath.FromSlash(p))
+}
+
+// Inject copies the distribution archive file into the directory of author
+// under authors/id, then adds its packages to 02packages and regenerates the
+// CHECKSUMS of the author.
+//
+// Packages already indexed with a higher version in another distribution
+// are not replaced.
+// The entries of the distribution are returned.
+func (r *Repo) Inject(file string, author string) ([]*CPAN.PackagesIndexEntry, error) {
+	if !reAuthor.MatchString(author) {
+		return nil, fmt.Errorf("invalid author ID %q", author)
+	}
+	name := filepath.Base(file)
+	if !CPAN.IsDistArchive(name) {
+		return nil, fmt.Errorf("%s: %s", name, CPAN.ErrUnsupportedArchive)
+	}
+	distPath := CPAN.AuthorDir(author) + "/" + name
+
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := CPAN.IndexDist(bytes.NewReader(content), distPath)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", name, err)
+	}
+
+	if err = CPAN.WriteFileAtomic(r.localPath("authors/id/"+distPath), bytes.NewReader(content), nil); err != nil {
+		return nil, err
+	}
+	r.logf("injected %s", distPath)
+
+	if err = r.WriteCheckSums(author); err != nil {
+		return nil, err
+	}
+	if err = r.updatePackagesIndex(distPath, entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ReadPackagesIndex returns the header and entries of 02packages.
+// If the file does not exist, no entries and no error are returned.
+func (r *Repo) ReadPackagesIndex() (map[string][]string, []*CPAN.PackagesIndexEntry, error) {
+	f, err := os.Open(r.localPath(CPAN.PackagesIndexPath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	header, ch, done := CPAN.ReadPackagesIndex(bufio.NewReader(f))
+	var entries []*CPAN.PackagesIndexEntry
+	for e := range ch {
+		entries = append(entries, e)
+	}
+	if err = <-done; err != nil {
+		return nil, nil, fmt.Errorf("%s: %s", CPAN.PackagesIndexPath, err)
+	}
+	return header, entries, nil
+}
+
+// updatePackagesIndex replaces the entries of distPath in 02packages.
+func (r *Repo) updatePackagesIndex(distPath string, entries []*CPAN.PackagesIndexEntry) error {
+	_, old, err := r.ReadPackagesIndex()
+	if err != nil {
+		return err
+	}
+
+	index := make(map[string]*CPAN.PackagesIndexEntry, len(old)+len(entries))
+	for _, e := range old {
+		// Entries of a previous injection of the same file are dropped
+		if e.Path != distPath {
+			index[e.Package] = e
+		}
+	}
+	for _, e := range entries {
+		if prev := index[e.Package]; prev != nil && CPAN.CompareVersions(e.Version, prev.Version) < 0 {
+			r.logf("%s: keep %s %s from %s", e.Package, prev.Package, prev.Version, prev.Path)
+			continue
+		}
+		index[e.Package] = e
+	}
+
+	all := make([]*CPAN.PackagesIndexEntry, 0, len(index))
+	for _, e := range index {
+		all = append(all, e)
+	}
+
+	var buf bytes.Buffer
+	if err = CPAN.WritePackagesIndex(&buf, nil, all); err != nil {
+		return err
+	}
+	return CPAN.WriteFileAtomic(r.localPath(CPAN.PackagesIndexPath), &buf, nil)
+}
+
+// WriteCheckSums regenerates the CHECKSUMS file of the directory of author.
+// The file is not signed.
+func (r *Repo) WriteCheckSums(author string) error {
+	dir := r.localPath("authors/id/" + CPAN.AuthorDir(author))
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	sums := make(map[string]CPAN.CheckSum, len(files))
+	for _, fi := range files {
+		name := fi.Name()
+		if name == "CHECKSUMS" || strings.HasPrefix(name, ".") {
+			continue
+		}
+		if fi.IsDir() {
+			sums[name] = CPAN.CheckSum{IsDir: 1}
+			continue
+		}
+		f, err := os.Open(filepath.Join(dir, name))
+		if err != nil {
+			return err
+		}
+		sum, err := CPAN.ComputeCheckSum(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+		sum.MTime = fi.ModTime().UTC().Format("2006-01-02")
+		sums[name] = sum
+	}
+
+	var buf bytes.Buffer
+	if err = CPAN.WriteCheckSums(&buf, sums); err != nil {
+		return err
+	}
+	return CPAN.WriteFileAtomic(filepath.Join(dir, "CHECKSUMS"), &buf, nil)
+}
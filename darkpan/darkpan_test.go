@@ -0,0 +1,67 @@
+package darkpan
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+func TestInject(t *testing.T) {
+	root, err := ioutil.TempDir("", "darkpan-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	repo := &Repo{Root: root, Logf: t.Logf}
+
+	if _, err = repo.Inject("../testdata/Foo-Bar-1.02.tar.gz", "dolmen"); err == nil {
+		t.Error("invalid author ID accepted")
+	}
+
+	entries, err := repo.Inject("../testdata/Foo-Bar-1.02.tar.gz", "DOLMEN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("got %d entries: %v", len(entries), entries)
+	}
+
+	// Injecting twice must not duplicate entries
+	if _, err = repo.Inject("../testdata/Foo-Bar-1.02.tar.gz", "DOLMEN"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, index, err := repo.ReadPackagesIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []CPAN.PackagesIndexEntry{
+		{Package: "Foo::Bar", Version: "1.02", Path: "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz"},
+		{Package: "Foo::Bar::Baz", Version: "0.5", Path: "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz"},
+	}
+	if len(index) != len(expected) {
+		t.Fatalf("02packages: got %d entries", len(index))
+	}
+	for i := range expected {
+		if *index[i] != expected[i] {
+			t.Errorf("entry %d: got %+v, expected %+v", i, *index[i], expected[i])
+		}
+	}
+
+	f, err := os.Open(filepath.Join(root, "authors/id/D/DO/DOLMEN/CHECKSUMS"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	sums, err := CPAN.ReadUnsignedCheckSums(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum, ok := sums["Foo-Bar-1.02.tar.gz"]; !ok || sum.Size == 0 {
+		t.Errorf("CHECKSUMS: %+v", sums)
+	}
+}
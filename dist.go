@@ -0,0 +1,379 @@
+package CPAN
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+)
+
+// ErrUnsupportedArchive is returned for distribution files that are not
+// in a supported archive format.
+var ErrUnsupportedArchive = errors.New("unsupported archive format")
+
+// UnsafePathError is returned when an archive contains an entry that would
+// be extracted outside of the extraction directory: absolute paths, ".."
+// components, or links that point outside.
+type UnsafePathError struct {
+	Name string
+	// Link is the target of the link, if Name is a link.
+	Link string
+}
+
+func (e *UnsafePathError) Error() string {
+	if e.Link != "" {
+		return fmt.Sprintf("unsafe link in archive: %q -> %q", e.Name, e.Link)
+	}
+	return fmt.Sprintf("unsafe path in archive: %q", e.Name)
+}
+
+// maxDistMetaSize is the maximum size of a file extracted by ReadDist.
+const maxDistMetaSize = 4 << 20
+
+// DistMetaFiles are the files of the top-level directory of a distribution
+// that are extracted by ReadDist.
+var DistMetaFiles = []string{
+	"META.json", "META.yml", "MYMETA.json", "MYMETA.yml",
+	"Makefile.PL", "Build.PL", "dist.ini", "cpanfile",
+}
+
+type archiveFormat int
+
+const (
+	formatUnknown archiveFormat = iota
+	formatTarGzip
+	formatTarBzip2
+	formatZip
+)
+
+func distFormat(name string) archiveFormat {
+	switch {
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return formatTarGzip
+	case strings.HasSuffix(name, ".tar.bz2"), strings.HasSuffix(name, ".tbz"):
+		return formatTarBzip2
+	case strings.HasSuffix(name, ".zip"):
+		return formatZip
+	}
+	return formatUnknown
+}
+
+// IsDistArchive reports whether name has the extension of a supported
+// distribution archive: .tar.gz, .tgz, .tar.bz2, .tbz or .zip.
+func IsDistArchive(name string) bool {
+	return distFormat(name) != formatUnknown
+}
+
+// DistFile is an entry of a distribution archive.
+type DistFile struct {
+	// Name is the path inside the archive, including the top-level
+	// directory. It is cleaned: no "./" prefix, no trailing slash.
+	Name string
+	Size int64
+	Mode os.FileMode
+	// Link is the target of a symbolic link or of a hard link.
+	Link string
+	// Reader is the content of a regular file, nil for other entries.
+	io.Reader
+}
+
+// IsRegular reports whether f is a regular file.
+func (f *DistFile) IsRegular() bool {
+	return f.Mode.IsRegular() && f.Link == ""
+}
+
+// WalkDist calls fn for each regular file of the distribution archive read
+// from r. name is the file name of the archive, used to detect its format.
+// The content of each file is streamed: it is only available during the
+// call to fn.
+//
+// An *UnsafePathError is returned if an entry of the archive (of any type)
+// could escape the extraction directory.
+//
+// Zip archives need random access: r is buffered in memory unless it
+// implements io.ReaderAt and has a Size method (like *bytes.Reader) or is
+// an *os.File.
+func WalkDist(r io.Reader, name string, fn func(f *DistFile) error) error {
+	return walkDistEntries(r, name, func(f *DistFile) error {
+		if !f.IsRegular() {
+			return nil
+		}
+		return fn(f)
+	})
+}
+
+func walkDistEntries(r io.Reader, name string, fn func(f *DistFile) error) error {
+	// Entries below a symbolic link of the archive would be extracted
+	// wherever the link points to
+	links := make(map[string]bool)
+	walk := fn
+	fn = func(f *DistFile) error {
+		for dir := path.Dir(f.Name); dir != "."; dir = path.Dir(dir) {
+			if links[dir] {
+				return &UnsafePathError{Name: f.Name}
+			}
+		}
+		if f.Mode&os.ModeSymlink != 0 {
+			links[f.Name] = true
+		}
+		return walk(f)
+	}
+
+	switch distFormat(name) {
+	case formatTarGzip:
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		return walkTar(gz, fn)
+	case formatTarBzip2:
+		return walkTar(bzip2.NewReader(r), fn)
+	case formatZip:
+		return walkZip(r, fn)
+	}
+	return ErrUnsupportedArchive
+}
+
+func walkTar(r io.Reader, fn func(f *DistFile) error) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		f := &DistFile{
+			Size: hdr.Size,
+			Mode: hdr.FileInfo().Mode(),
+		}
+		switch hdr.Typeflag {
+		case tar.TypeReg:
+			f.Reader = tr
+		case tar.TypeSymlink, tar.TypeLink:
+			f.Link = hdr.Linkname
+		case tar.TypeDir:
+		case tar.TypeXGlobalHeader:
+			continue
+		default:
+			// Devices, FIFOs...: never in a distribution
+			continue
+		}
+		if f.Name, err = cleanDistPath(hdr.Name, f.Link, hdr.Typeflag == tar.TypeLink); err != nil {
+			return err
+		}
+		if err = fn(f); err != nil {
+			return err
+		}
+	}
+}
+
+func walkZip(r io.Reader, fn func(f *DistFile) error) error {
+	var (
+		ra   io.ReaderAt
+		size int64
+	)
+	switch r := r.(type) {
+	case *os.File:
+		fi, err := r.Stat()
+		if err != nil {
+			return err
+		}
+		ra, size = r, fi.Size()
+	case interface {
+		io.ReaderAt
+		Size() int64
+	}:
+		ra, size = r, r.Size()
+	default:
+		buf, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		ra, size = bytes.NewReader(buf), int64(len(buf))
+	}
+
+	zr, err := zip.NewReader(ra, size)
+	if err != nil {
+		return err
+	}
+	for _, zf := range zr.File {
+		f := &DistFile{
+			Size: int64(zf.UncompressedSize64),
+			Mode: zf.Mode(),
+		}
+		if f.Mode&os.ModeSymlink != 0 {
+			// The target of the link is the content
+			rc, err := zf.Open()
+			if err != nil {
+				return err
+			}
+			target, err := ioutil.ReadAll(io.LimitReader(rc, 4096))
+			rc.Close()
+			if err != nil {
+				return err
+			}
+			f.Link = string(target)
+		}
+		if f.Name, err = cleanDistPath(zf.Name, f.Link, false); err != nil {
+			return err
+		}
+		if !f.Mode.IsRegular() {
+			if err = fn(f); err != nil {
+				return err
+			}
+			continue
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			return err
+		}
+		f.Reader = rc
+		err = fn(f)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanDistPath checks that the entry name (and its link target) stays
+// inside the extraction directory and returns the cleaned name.
+// The target of a hard link is relative to the archive root, the target of
+// a symbolic link is relative to the directory of the link.
+func cleanDistPath(name, link string, hardLink bool) (string, error) {
+	if !isSafeRelPath(name) {
+		return "", &UnsafePathError{Name: name}
+	}
+	clean := path.Clean(strings.Replace(name, `\`, "/", -1))
+	if link != "" {
+		target := strings.Replace(link, `\`, "/", -1)
+		safe := isSafeRelPath(target)
+		if !hardLink && !isAbsPath(target) {
+			safe = isSafeRelPath(path.Join(path.Dir(clean), target))
+		}
+		if !safe {
+			return "", &UnsafePathError{Name: name, Link: link}
+		}
+	}
+	return clean, nil
+}
+
+// isSafeRelPath reports whether p is a relative path that, once cleaned,
+// does not go up from its root.
+func isSafeRelPath(p string) bool {
+	p = strings.Replace(p, `\`, "/", -1)
+	if p == "" || isAbsPath(p) {
+		return false
+	}
+	clean := path.Clean(p)
+	return clean != ".." && !strings.HasPrefix(clean, "../")
+}
+
+func isAbsPath(p string) bool {
+	return strings.HasPrefix(p, "/") || len(p) >= 2 && p[1] == ':'
+}
+
+// Dist is the content of a distribution archive, as read by ReadDist.
+type Dist struct {
+	// TopDir is the directory that contains all the entries of the archive,
+	// such as "Foo-Bar-1.02". It is empty if the entries are not all in the
+	// same directory.
+	TopDir string
+	// Entries are all the entries of the archive, without their content.
+	Entries []DistFile
+	// Files are the contents of the DistMetaFiles found in TopDir, by name.
+	Files map[string][]byte
+	// Meta is the first valid file of META.json, META.yml, MYMETA.json and
+	// MYMETA.yml. It is nil if the distribution has none.
+	Meta *Meta
+}
+
+// ReadDist reads the distribution archive r, lists its entries and
+// extracts the DistMetaFiles of the top-level directory, without writing to
+// disk. name is the file name of the archive, used to detect its format.
+func ReadDist(r io.Reader, name string) (*Dist, error) {
+	d := &Dist{Files: make(map[string][]byte)}
+	var (
+		topDir string
+		single = true
+		// The top-level directory is not known before the end: files are
+		// kept with their full name until then
+		files = make(map[string][]byte)
+	)
+	err := walkDistEntries(r, name, func(f *DistFile) error {
+		entry := *f
+		entry.Reader = nil
+		d.Entries = append(d.Entries, entry)
+
+		top, rel := f.Name, ""
+		if i := strings.IndexByte(f.Name, '/'); i >= 0 {
+			top, rel = f.Name[:i], f.Name[i+1:]
+		} else if f.Mode.IsDir() {
+			rel = "."
+		}
+		if single {
+			switch {
+			case rel == "":
+				single = false
+			case topDir == "":
+				topDir = top
+			case top != topDir:
+				single = false
+			}
+		}
+
+		base := path.Base(f.Name)
+		if !f.IsRegular() || !contains(DistMetaFiles, base) || strings.Count(f.Name, "/") > 1 {
+			return nil
+		}
+		buf, err := ioutil.ReadAll(io.LimitReader(f, maxDistMetaSize+1))
+		if err != nil {
+			return err
+		}
+		if len(buf) > maxDistMetaSize {
+			return fmt.Errorf("%s: file too large", f.Name)
+		}
+		files[f.Name] = buf
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if single {
+		d.TopDir = topDir
+	}
+	for p, buf := range files {
+		rel := p
+		if d.TopDir != "" {
+			rel = strings.TrimPrefix(p, d.TopDir+"/")
+		}
+		if rel != path.Base(rel) {
+			// In a subdirectory
+			continue
+		}
+		d.Files[rel] = buf
+	}
+
+	for _, f := range []string{"META.json", "META.yml", "MYMETA.json", "MYMETA.yml"} {
+		if buf, ok := d.Files[f]; ok {
+			if m, err := ParseMeta(buf); err == nil {
+				d.Meta = m
+				break
+			}
+		}
+	}
+	return d, nil
+}
@@ -0,0 +1,147 @@
+package CPAN
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestReadDist(t *testing.T) {
+	for _, name := range []string{"Foo-Bar-1.02.tar.gz", "Foo-Bar-1.02.tar.bz2"} {
+		f, err := os.Open("testdata/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := ReadDist(f, name)
+		f.Close()
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if d.TopDir != "Foo-Bar-1.02" {
+			t.Errorf("%s: TopDir: %q", name, d.TopDir)
+		}
+		found := false
+		for _, e := range d.Entries {
+			if e.Name == "Foo-Bar-1.02/lib/Foo/Bar/Baz.pm" && e.IsRegular() && e.Reader == nil {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s: entries: %+v", name, d.Entries)
+		}
+		for _, file := range []string{"META.json", "META.yml", "Makefile.PL"} {
+			if len(d.Files[file]) == 0 {
+				t.Errorf("%s: %s not extracted", name, file)
+			}
+		}
+		if d.Meta == nil || d.Meta.Name != "Foo-Bar" || d.Meta.MetaSpec.URL == "" {
+			t.Errorf("%s: Meta: %+v", name, d.Meta)
+		}
+	}
+}
+
+func TestReadDistZip(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range []struct{ name, content string }{
+		{"Foo-1.0/", ""},
+		{"Foo-1.0/dist.ini", "name = Foo\n"},
+		{"Foo-1.0/t/META.json", "{}"},
+	} {
+		w, _ := zw.Create(f.name)
+		w.Write([]byte(f.content))
+	}
+	zw.Close()
+
+	d, err := ReadDist(bytes.NewReader(buf.Bytes()), "Foo-1.0.zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.TopDir != "Foo-1.0" || len(d.Entries) != 3 {
+		t.Errorf("got %+v", d)
+	}
+	if string(d.Files["dist.ini"]) != "name = Foo\n" || len(d.Files) != 1 || d.Meta != nil {
+		t.Errorf("files: %q", d.Files)
+	}
+
+	// Without io.ReaderAt
+	d, err = ReadDist(ioutil.NopCloser(bytes.NewReader(buf.Bytes())), "Foo-1.0.zip")
+	if err != nil || d.TopDir != "Foo-1.0" {
+		t.Errorf("got %+v, %v", d, err)
+	}
+}
+
+func TestReadDistNoTopDir(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range []string{"Foo-1.0/lib/Foo.pm", "cpanfile"} {
+		w, _ := zw.Create(name)
+		w.Write([]byte("1;\n"))
+	}
+	zw.Close()
+
+	d, err := ReadDist(bytes.NewReader(buf.Bytes()), "Foo-1.0.zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.TopDir != "" || d.Files["cpanfile"] == nil {
+		t.Errorf("got %+v", d)
+	}
+}
+
+func TestWalkDistUnsafe(t *testing.T) {
+	for _, hdr := range []tar.Header{
+		{Name: "/etc/passwd", Typeflag: tar.TypeReg},
+		{Name: "Foo-1.0/../../evil", Typeflag: tar.TypeReg},
+		{Name: `..\evil`, Typeflag: tar.TypeReg},
+		{Name: "C:/evil", Typeflag: tar.TypeReg},
+		{Name: "Foo-1.0/link", Typeflag: tar.TypeSymlink, Linkname: "../../etc"},
+		{Name: "Foo-1.0/link", Typeflag: tar.TypeSymlink, Linkname: "/etc/passwd"},
+		{Name: "Foo-1.0/link", Typeflag: tar.TypeLink, Linkname: "../x"},
+	} {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gz)
+		hdr.Mode = 0644
+		tw.WriteHeader(&hdr)
+		tw.Close()
+		gz.Close()
+
+		err := WalkDist(&buf, "Foo-1.0.tar.gz", func(*DistFile) error { return nil })
+		if _, ok := err.(*UnsafePathError); !ok {
+			t.Errorf("%s -> %s: got %v", hdr.Name, hdr.Linkname, err)
+		}
+	}
+
+	// A file written through a link
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	tw.WriteHeader(&tar.Header{Name: "Foo-1.0/up", Typeflag: tar.TypeSymlink, Linkname: "..", Mode: 0777})
+	tw.WriteHeader(&tar.Header{Name: "Foo-1.0/up/up/evil", Typeflag: tar.TypeReg, Mode: 0644})
+	tw.Close()
+	gz.Close()
+	err := WalkDist(&buf, "Foo-1.0.tar.gz", func(*DistFile) error { return nil })
+	if _, ok := err.(*UnsafePathError); !ok {
+		t.Errorf("write through link: got %v", err)
+	}
+
+	// A link inside the distribution is fine
+	buf.Reset()
+	gz = gzip.NewWriter(&buf)
+	tw = tar.NewWriter(gz)
+	tw.WriteHeader(&tar.Header{Name: "Foo-1.0/lib/link", Typeflag: tar.TypeSymlink, Linkname: "../README", Mode: 0777})
+	tw.Close()
+	gz.Close()
+	d, err := ReadDist(&buf, "Foo-1.0.tar.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Entries) != 1 || d.Entries[0].Link != "../README" || d.Entries[0].IsRegular() {
+		t.Errorf("got %+v", d.Entries)
+	}
+}
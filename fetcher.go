@@ -0,0 +1,208 @@
+package CPAN
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+)
+
+// Fetcher downloads distributions into a content-addressed cache: files
+// are stored by sha256, so a file is downloaded once whatever its path and
+// mirror.
+//
+// Each distribution is verified against the CHECKSUMS of its author
+// directory, whose signature is checked by Client, while it is downloaded.
+// The CHECKSUMS are kept in memory, and fetched again only for files they
+// don't list.
+// Concurrent fetches of the same file share a single download, which is
+// cancelled only when all the callers are gone.
+type Fetcher struct {
+	// Client fetches the CHECKSUMS files. Its mirrors, HTTP client and
+	// retry settings are used for the distributions.
+	Client *Client
+	// Dir is the directory of the cache.
+	Dir string
+
+	mu        sync.Mutex
+	inflight  map[string]*fetchCall
+	checkSums map[string]map[string]CheckSum
+}
+
+// fetchCall is a download in progress.
+type fetchCall struct {
+	done chan struct{}
+	err  error
+	// waiters is the number of callers waiting for done, guarded by
+	// Fetcher.mu. cancel aborts the download when it drops to zero.
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// CachePath returns the path of the file with the given sha256 (in
+// hexadecimal) in the cache: Dir/ab/abcdef...
+// An error is returned if sha256 is not 64 hexadecimal digits.
+func (f *Fetcher) CachePath(sha256 string) (string, error) {
+	if _, err := hex.DecodeString(sha256); err != nil || len(sha256) != 64 {
+		return "", fmt.Errorf("invalid sha256 %q", sha256)
+	}
+	return filepath.Join(f.Dir, sha256[:2], sha256), nil
+}
+
+// Fetch returns the path in the cache of the distribution distPath
+// (relative to authors/id, such as "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz"),
+// downloading it if needed, and its checksum.
+func (f *Fetcher) Fetch(ctx context.Context, distPath string) (string, CheckSum, error) {
+	if err := checkPath(distPath); err != nil {
+		return "", CheckSum{}, err
+	}
+	dir, name := path.Split(distPath)
+	if dir == "" {
+		return "", CheckSum{}, fmt.Errorf("invalid path %q", distPath)
+	}
+	sum, err := f.checkSum(ctx, dir, name)
+	if err != nil {
+		return "", CheckSum{}, err
+	}
+	if sum.IsDir != 0 {
+		return "", CheckSum{}, fmt.Errorf("%s: not listed in CHECKSUMS", distPath)
+	}
+	if sum.Sha256 == "" {
+		return "", CheckSum{}, fmt.Errorf("%s: no sha256 in CHECKSUMS", distPath)
+	}
+	file, err := f.CachePath(sum.Sha256)
+	if err != nil {
+		return "", CheckSum{}, fmt.Errorf("%s: %s", distPath, err)
+	}
+	// Files are verified before being stored
+	if fi, err := os.Stat(file); err == nil && fi.Size() == int64(sum.Size) {
+		return file, sum, nil
+	}
+
+	f.mu.Lock()
+	call := f.inflight[sum.Sha256]
+	if call == nil {
+		// The download is not bound to the context of this caller, which
+		// may go away before the others
+		dctx, cancel := context.WithCancel(context.Background())
+		call = &fetchCall{done: make(chan struct{}), cancel: cancel}
+		if f.inflight == nil {
+			f.inflight = make(map[string]*fetchCall)
+		}
+		f.inflight[sum.Sha256] = call
+		go func(sum CheckSum) {
+			call.err = f.download(dctx, distPath, &sum, file)
+			cancel()
+			f.mu.Lock()
+			if f.inflight[sum.Sha256] == call {
+				delete(f.inflight, sum.Sha256)
+			}
+			f.mu.Unlock()
+			close(call.done)
+		}(sum)
+	}
+	call.waiters++
+	f.mu.Unlock()
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		f.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			// Later callers start a new download
+			call.cancel()
+			if f.inflight[sum.Sha256] == call {
+				delete(f.inflight, sum.Sha256)
+			}
+		}
+		f.mu.Unlock()
+		return "", CheckSum{}, ctx.Err()
+	}
+	if call.err != nil {
+		return "", CheckSum{}, call.err
+	}
+	return file, sum, nil
+}
+
+// checkSum returns the checksum of name from the CHECKSUMS of dir. The
+// CHECKSUMS are fetched again if name is not listed in the cached copy.
+func (f *Fetcher) checkSum(ctx context.Context, dir, name string) (CheckSum, error) {
+	f.mu.Lock()
+	sum, ok := f.checkSums[dir][name]
+	f.mu.Unlock()
+	if ok {
+		return sum, nil
+	}
+
+	sums, err := f.Client.CheckSums(ctx, dir)
+	if err != nil {
+		return CheckSum{}, err
+	}
+	f.mu.Lock()
+	if f.checkSums == nil {
+		f.checkSums = make(map[string]map[string]CheckSum)
+	}
+	f.checkSums[dir] = sums
+	f.mu.Unlock()
+
+	if sum, ok = sums[name]; !ok {
+		return CheckSum{}, fmt.Errorf("%s%s: not listed in CHECKSUMS", dir, name)
+	}
+	return sum, nil
+}
+
+// Open is like Fetch, but returns the file opened for reading.
+func (f *Fetcher) Open(ctx context.Context, distPath string) (*os.File, error) {
+	file, _, err := f.Fetch(ctx, distPath)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(file)
+}
+
+// download downloads distPath from the mirrors into file, verifying sum.
+func (f *Fetcher) download(ctx context.Context, distPath string, sum *CheckSum, file string) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0777); err != nil {
+		return err
+	}
+	p := "authors/id/" + distPath
+	err := f.Client.tryMirrors(ctx, p, func(url string) error {
+		return f.try(ctx, url, sum, file)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", p, err)
+	}
+	return nil
+}
+
+func (f *Fetcher) try(ctx context.Context, url string, sum *CheckSum, file string) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := f.Client.httpClient().Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return ErrNotFound
+	default:
+		return fmt.Errorf("%s: HTTP status %s", url, resp.Status)
+	}
+
+	// Don't read more than expected from a broken mirror
+	body := io.LimitReader(resp.Body, int64(sum.Size)+1)
+	if err = WriteFileAtomic(file, body, sum); err != nil {
+		return fmt.Errorf("%s: %s", url, err)
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+package CPAN
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/clearsign"
+)
+
+func signCheckSums(t *testing.T, signer *openpgp.Entity, sums map[string]CheckSum) []byte {
+	var text, signed bytes.Buffer
+	if err := WriteCheckSums(&text, sums); err != nil {
+		t.Fatal(err)
+	}
+	w, err := clearsign.Encode(&signed, signer.PrivateKey, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write(text.Bytes())
+	w.Close()
+	return signed.Bytes()
+}
+
+func TestFetcher(t *testing.T) {
+	signer, err := openpgp.NewEntity("PAUSE test", "", "pause@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := []byte("Foo-1.0 tarball content")
+	sum, _ := ComputeCheckSum(bytes.NewReader(content))
+	sums := map[string]CheckSum{"Foo-1.0.tar.gz": sum}
+	checksums := signCheckSums(t, signer, sums)
+
+	var downloads, checksumsRequests int32
+	mux := http.NewServeMux()
+	for _, dir := range []string{"D/DO/DOLMEN", "O/OT/OTHER"} {
+		mux.HandleFunc("/authors/id/"+dir+"/CHECKSUMS", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&checksumsRequests, 1)
+			w.Write(checksums)
+		})
+		mux.HandleFunc("/authors/id/"+dir+"/Foo-1.0.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&downloads, 1)
+			time.Sleep(50 * time.Millisecond)
+			w.Write(content)
+		})
+	}
+	good := httptest.NewServer(mux)
+	defer good.Close()
+
+	corrupted := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/CHECKSUMS") {
+			w.Write(checksums)
+			return
+		}
+		w.Write(bytes.ToUpper(content))
+	}))
+	defer corrupted.Close()
+
+	dir, err := ioutil.TempDir("", "cpan-fetcher-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	f := &Fetcher{
+		Client: &Client{
+			Mirrors: []string{corrupted.URL, good.URL},
+			KeyRing: openpgp.EntityList{signer},
+		},
+		Dir: dir,
+	}
+	ctx := context.Background()
+	cachePath, err := f.CachePath(sum.Sha256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			file, got, err := f.Fetch(ctx, "D/DO/DOLMEN/Foo-1.0.tar.gz")
+			if err == nil && (got != sum || file != cachePath) {
+				err = fmt.Errorf("got %s %+v", file, got)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if downloads != 1 {
+		t.Errorf("got %d downloads", downloads)
+	}
+
+	// The CHECKSUMS are not fetched again
+	n := atomic.LoadInt32(&checksumsRequests)
+	if _, _, err = f.Fetch(ctx, "D/DO/DOLMEN/Foo-1.0.tar.gz"); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&checksumsRequests); got != n {
+		t.Errorf("CHECKSUMS fetched again: %d requests", got-n)
+	}
+
+	// Same content from another path: no download
+	r, err := f.Open(ctx, "O/OT/OTHER/Foo-1.0.tar.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := ioutil.ReadAll(r)
+	r.Close()
+	if !bytes.Equal(got, content) {
+		t.Errorf("got %q", got)
+	}
+	if downloads != 1 {
+		t.Errorf("got %d downloads", downloads)
+	}
+	h := sha256.Sum256(got)
+	if hex.EncodeToString(h[:]) != sum.Sha256 {
+		t.Error("sha256 mismatch")
+	}
+
+	// Only the corrupted mirror
+	f = &Fetcher{Client: &Client{Mirrors: []string{corrupted.URL}, KeyRing: openpgp.EntityList{signer}}, Dir: dir + "/other"}
+	if _, _, err = f.Fetch(ctx, "D/DO/DOLMEN/Foo-1.0.tar.gz"); err == nil || !strings.Contains(err.Error(), "mismatch") {
+		t.Errorf("corrupted file: got error %v", err)
+	}
+	if cachePath, err = f.CachePath(sum.Sha256); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(cachePath); err == nil {
+		t.Error("corrupted file stored in the cache")
+	}
+
+	if _, _, err = f.Fetch(ctx, "D/DO/DOLMEN/Bar-1.0.tar.gz"); err == nil || !strings.Contains(err.Error(), "not listed") {
+		t.Errorf("got error %v", err)
+	}
+}
+
+func TestFetcherCancel(t *testing.T) {
+	signer, err := openpgp.NewEntity("PAUSE test", "", "pause@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := []byte("Foo-1.0 tarball content")
+	sum, _ := ComputeCheckSum(bytes.NewReader(content))
+	checksums := signCheckSums(t, signer, map[string]CheckSum{"Foo-1.0.tar.gz": sum})
+
+	var downloads int32
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	aborted := make(chan struct{}, 2)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authors/id/D/DO/DOLMEN/CHECKSUMS", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(checksums)
+	})
+	mux.HandleFunc("/authors/id/D/DO/DOLMEN/Foo-1.0.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&downloads, 1)
+		started <- struct{}{}
+		select {
+		case <-release:
+			w.Write(content)
+		case <-r.Context().Done():
+			aborted <- struct{}{}
+		}
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "cpan-fetcher-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	f := &Fetcher{
+		Client: &Client{Mirrors: []string{srv.URL}, KeyRing: openpgp.EntityList{signer}},
+		Dir:    dir,
+	}
+	cachePath, err := f.CachePath(sum.Sha256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// waitFor waits until n callers wait for the download of sum
+	waitFor := func(n int) {
+		for {
+			f.mu.Lock()
+			call := f.inflight[sum.Sha256]
+			ok := call != nil && call.waiters == n
+			f.mu.Unlock()
+			if ok {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	// The first caller goes away: the download continues for the second one
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	errs := make(chan error, 2)
+	go func() {
+		_, _, err := f.Fetch(ctx1, "D/DO/DOLMEN/Foo-1.0.tar.gz")
+		errs <- err
+	}()
+	<-started
+	go func() {
+		_, _, err := f.Fetch(context.Background(), "D/DO/DOLMEN/Foo-1.0.tar.gz")
+		errs <- err
+	}()
+	waitFor(2)
+	cancel1()
+	if err = <-errs; err != context.Canceled {
+		t.Errorf("first caller: got %v", err)
+	}
+	close(release)
+	if err = <-errs; err != nil {
+		t.Errorf("second caller: %v", err)
+	}
+	if downloads != 1 {
+		t.Errorf("got %d downloads", downloads)
+	}
+	if _, err = os.Stat(cachePath); err != nil {
+		t.Error(err)
+	}
+
+	// All the callers go away: the download is cancelled
+	os.Remove(cachePath)
+	release = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		_, _, err := f.Fetch(ctx, "D/DO/DOLMEN/Foo-1.0.tar.gz")
+		errs <- err
+	}()
+	<-started
+	cancel()
+	if err = <-errs; err != context.Canceled {
+		t.Errorf("got %v", err)
+	}
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Error("download not cancelled")
+	}
+}
+
+func TestFetcherCachePath(t *testing.T) {
+	f := &Fetcher{Dir: "cache"}
+	sha := strings.Repeat("ab", 32)
+	got, err := f.CachePath(sha)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join("cache", "ab", sha); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	for _, sha := range []string{"", "a", "../../etc", strings.Repeat("ab", 31), strings.Repeat("zz", 32)} {
+		if _, err := f.CachePath(sha); err == nil {
+			t.Errorf("%q: no error", sha)
+		}
+	}
+}
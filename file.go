@@ -0,0 +1,61 @@
+package CPAN
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// WriteFileAtomic installs the content of r as the file dest, creating the
+// missing directories. The content goes first to a temporary file in the same
+// directory which is renamed once complete, so readers never see a partial
+// file.
+// If sum is not nil, the content must match its size and sha256.
+func WriteFileAtomic(dest string, r io.Reader, sum *CheckSum) error {
+	return writeFileAtomic(dest, func(tmp *os.File) error {
+		if sum == nil {
+			_, err := io.Copy(tmp, r)
+			return err
+		}
+		v := NewCheckSumVerifier(sum)
+		if _, err := io.Copy(io.MultiWriter(tmp, v), r); err != nil {
+			return err
+		}
+		return v.Verify()
+	})
+}
+
+// writeFileAtomic creates a temporary file next to dest, fills it with write
+// and renames it to dest. The temporary file is removed on failure.
+func writeFileAtomic(dest string, write func(tmp *os.File) error) error {
+	dir := filepath.Dir(dest)
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if tmp != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	if err = write(tmp); err != nil {
+		return err
+	}
+	if err = tmp.Chmod(0644); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp.Name(), dest); err != nil {
+		return err
+	}
+	tmp = nil
+	return nil
+}
@@ -0,0 +1,49 @@
+package CPAN
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cpan-file-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	dest := filepath.Join(dir, "a", "b", "file")
+	sum, err := ComputeCheckSum(strings.NewReader("content"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = WriteFileAtomic(dest, strings.NewReader("corrupted"), &sum); err == nil {
+		t.Fatal("checksum mismatch not detected")
+	}
+	if _, err = os.Stat(dest); !os.IsNotExist(err) {
+		t.Errorf("file installed despite the mismatch: %v", err)
+	}
+
+	if err = WriteFileAtomic(dest, strings.NewReader("content"), &sum); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile(dest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "content" {
+		t.Errorf("got %q", got)
+	}
+
+	files, err := ioutil.ReadDir(filepath.Dir(dest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("temporary files left: %d files", len(files))
+	}
+}
@@ -0,0 +1,307 @@
+//go:build go1.18
+// +build go1.18
+
+package CPAN
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/openpgp/clearsign"
+)
+
+// The seed corpus comes from testdata. Run a target with:
+//
+//	go test -run XXX -fuzz FuzzParseCheckSums
+
+func readTestData(f *testing.F, file string) []byte {
+	buf, err := ioutil.ReadFile("testdata/" + file)
+	if err != nil {
+		f.Fatal(err)
+	}
+	return buf
+}
+
+// checkCheckSumsRoundTrip checks that sums are read back from the output of
+// WriteCheckSums.
+func checkCheckSumsRoundTrip(t *testing.T, sums map[string]CheckSum) {
+	var buf bytes.Buffer
+	if err := WriteCheckSums(&buf, sums); err != nil {
+		t.Fatal(err)
+	}
+	got, err := parseCheckSums(buf.Bytes())
+	if err != nil {
+		t.Fatalf("%s\n%s", err, buf.Bytes())
+	}
+	// Only isdir is written for directories
+	expected := make(map[string]CheckSum, len(sums))
+	for name, sum := range sums {
+		if sum.IsDir != 0 {
+			sum = CheckSum{IsDir: 1}
+		}
+		expected[name] = sum
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got %+v, expected %+v", got, expected)
+	}
+}
+
+func FuzzParseCheckSums(f *testing.F) {
+	block, _ := clearsign.Decode(readTestData(f, "CHECKSUMS"))
+	f.Add(block.Bytes)
+	var buf bytes.Buffer
+	WriteCheckSums(&buf, map[string]CheckSum{
+		"Foo-1.0.tar.gz": {MD5: "d41d8cd98f00b204e9800998ecf8427e", MTime: "2017-06-19", Sha256: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Size: 0},
+		"sub":            {IsDir: 1},
+	})
+	f.Add(buf.Bytes())
+	f.Add([]byte("# comment\n$cksum = {};\n"))
+	f.Add([]byte(`{'RECENT-2d.yaml' => {'size' => '35228'}}`))
+	f.Add([]byte(`{'a=>' => {}}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		sums, err := parseCheckSums(append([]byte(nil), data...))
+		if err != nil {
+			return
+		}
+		checkCheckSumsRoundTrip(t, sums)
+	})
+}
+
+func FuzzReadCheckSums(f *testing.F) {
+	f.Add(readTestData(f, "CHECKSUMS"))
+	f.Add([]byte("-----BEGIN PGP SIGNED MESSAGE-----\nHash: SHA1\n\n{}\n-----BEGIN PGP SIGNATURE-----\n\n-----END PGP SIGNATURE-----\n"))
+	f.Add([]byte("{}"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		unsigned, uerr := ReadUnsignedCheckSums(bytes.NewReader(data))
+		sums, err := ReadCheckSums(bytes.NewReader(data), PAUSEKeyRing)
+		if err != nil {
+			return
+		}
+		// A signed file is also valid unsigned
+		if uerr != nil || !reflect.DeepEqual(sums, unsigned) {
+			t.Fatalf("signed: %v, unsigned: %v (%v)", sums, unsigned, uerr)
+		}
+	})
+}
+
+func FuzzSplitPackagesLine(f *testing.F) {
+	f.Add("Foo::Bar                           1.02  D/DO/DOLMEN/Foo-Bar-1.02.tar.gz")
+	f.Add("Acme::Don't 0.01_01 D/DO/DO/Acme-Don-t-0.01_01.tar.gz")
+	f.Add("Foo v1.2.3 D/DO/DOLMEN/sub/../../Foo-v1.2.3.tar.gz")
+	f.Add("   ")
+
+	f.Fuzz(func(t *testing.T, line string) {
+		pkg, version, path, err := splitPackagesLine([]byte(line))
+		if err != nil {
+			return
+		}
+		e := &PackagesIndexEntry{Package: string(pkg), Version: string(version), Path: string(path)}
+		if strings.ContainsAny(line, "\n\r\t") {
+			t.Fatalf("%q: control chars accepted", line)
+		}
+		if !strings.HasPrefix(e.Path, AuthorDir(e.Author())+"/") {
+			t.Fatalf("%q: invalid author directory", e.Path)
+		}
+		for _, seg := range strings.Split(e.Path, "/") {
+			switch seg {
+			case "", ".", "..":
+				t.Fatalf("%q: invalid path", e.Path)
+			}
+		}
+
+		// Round trip through the writer
+		var buf bytes.Buffer
+		if err := WritePackagesIndex(&buf, nil, []*PackagesIndexEntry{e}); err != nil {
+			t.Fatal(err)
+		}
+		_, entries, err := ParsePackagesIndex(&buf, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != 1 || *entries[0] != *e {
+			t.Fatalf("got %+v, expected %+v", entries, e)
+		}
+	})
+}
+
+// FuzzPackagesIndex checks that the parsers of 02packages agree on the
+// (uncompressed) content.
+func FuzzPackagesIndex(f *testing.F) {
+	r, err := gzip.NewReader(bytes.NewReader(readTestData(f, "02packages.details.txt.gz")))
+	if err != nil {
+		f.Fatal(err)
+	}
+	content, _ := ioutil.ReadAll(r)
+	lines := bytes.SplitAfter(content, []byte{'\n'})
+	f.Add(bytes.Join(lines[:30], nil))
+	f.Add([]byte("File: 02packages.details.txt\n\nFoo 1.0 F/FO/FOO/Foo-1.0.tar.gz\r\nFoo::Bar\nBar undef B/BA/BAR/Bar-1.tgz"))
+	f.Add([]byte("File: 02packages.details.txt\n\n   \n"))
+
+	defer func(size int) { PackagesIndexBlockSize = size }(PackagesIndexBlockSize)
+	PackagesIndexBlockSize = 64
+
+	f.Fuzz(func(t *testing.T, content []byte) {
+		if len(content) > 32<<10 {
+			// Longer than the buffer of bufio.Scanner
+			return
+		}
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		gz.Write(content)
+		gz.Close()
+		data := buf.Bytes()
+
+		var expected []PackagesIndexEntry
+		header, scanErr := ScanPackagesIndex(bytes.NewReader(data), func(pkg, version, path []byte) error {
+			expected = append(expected, PackagesIndexEntry{Package: string(pkg), Version: string(version), Path: string(path)})
+			return nil
+		})
+		check := func(name string, h map[string][]string, entries []*PackagesIndexEntry, err error, n int) {
+			t.Helper()
+			if !reflect.DeepEqual(err, scanErr) {
+				t.Fatalf("%s: got error %v, expected %v", name, err, scanErr)
+			}
+			if !reflect.DeepEqual(h, header) {
+				t.Fatalf("%s: got header %v, expected %v", name, h, header)
+			}
+			if len(entries) != n {
+				t.Fatalf("%s: got %d entries, expected %d", name, len(entries), n)
+			}
+			for i, e := range entries {
+				if *e != expected[i] {
+					t.Fatalf("%s: got %+v, expected %+v", name, e, expected[i])
+				}
+			}
+		}
+
+		h, ch, done := ReadPackagesIndex(bytes.NewReader(data))
+		var entries []*PackagesIndexEntry
+		for e := range ch {
+			entries = append(entries, e)
+		}
+		check("read", h, entries, <-done, len(expected))
+
+		h, entries, err := ParsePackagesIndex(bytes.NewReader(data), 3)
+		n := len(expected)
+		if err != nil {
+			n = 0
+		}
+		check("parse", h, entries, err, n)
+
+		h, entries, err = ParsePackagesIndexLenient(bytes.NewReader(data), 3)
+		if errs, ok := err.(ParseErrors); ok {
+			err = errs[0]
+			// The valid lines up to the first error
+			entries = entries[:len(expected)]
+		}
+		check("lenient", h, entries, err, len(expected))
+	})
+}
+
+func FuzzParseVersion(f *testing.F) {
+	for _, s := range []string{"1.02", "v1.2.3", "1.2.3_01", "0.01_02", ".5", "1.", "undef", "", "_", "v", "1.0foo", "99999999999999999999"} {
+		f.Add(s)
+	}
+	f.Add(">= 1.2, != 1.5, < 2.0")
+	f.Add("== v1.2.3")
+	f.Add("0")
+	f.Add(">=, 1")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if v, err := ParseVersion(s); err == nil {
+			// The normalized form is parsed back to the same version
+			w, err := ParseVersion(v.String())
+			if err != nil {
+				t.Fatalf("%q: %s: %s", s, v, err)
+			}
+			if v.Cmp(w) != 0 || w.Cmp(v) != 0 {
+				t.Fatalf("%q: %s != %s", s, v, w)
+			}
+		}
+
+		r, err := ParseVersionRange(s)
+		if err != nil {
+			return
+		}
+		r.AcceptsString(s)
+		// The range is parsed back from its string
+		str := r.String()
+		r2, err := ParseVersionRange(str)
+		if err != nil {
+			t.Fatalf("%q: %q: %s", s, str, err)
+		}
+		if str2 := r2.String(); str2 != str {
+			t.Fatalf("%q: %q != %q", s, str2, str)
+		}
+	})
+}
+
+func FuzzParseMeta(f *testing.F) {
+	f.Add(readTestData(f, "META-v1.4.yml"))
+	f.Add(readTestData(f, "META-v2.json"))
+	f.Add([]byte(`{"meta-spec":{"version":2},"prereqs":{"runtime":{"requires":{"Foo":"_"}}}}`))
+	f.Add([]byte("name: Foo\nrequires:\n  Bar: 1.10\n"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		m, err := ParseMeta(append([]byte(nil), data...))
+		if err != nil {
+			return
+		}
+		m.Validate()
+		m.NoIndex.SkipsFile("lib/Foo.pm")
+		m.NoIndex.SkipsPackage("Foo::Bar")
+	})
+}
+
+func FuzzReadCpanfile(f *testing.F) {
+	f.Add("requires 'Foo', '1.0';\non test => sub {\n    requires 'Test::More', '>= 0.98, < 2';\n};\n")
+	f.Add("feature 'sqlite', 'SQLite support' => sub { recommends 'DBD::SQLite' };\nmirror 'https://cpan.example.com';\n")
+	f.Add("requires 'Foo', dist => 'DOLMEN/Foo-1.0.tar.gz';\n")
+	f.Add("requires q{Foo}, qq(1.0);\n=pod\n\nrequires 'Bar';\n\n=cut\n")
+	f.Add("requires 'Foo', '_';")
+
+	f.Fuzz(func(t *testing.T, src string) {
+		_, err := ReadCpanfile(strings.NewReader(src))
+		if err == nil {
+			return
+		}
+		var cerr *CpanfileError
+		if !errors.As(err, &cerr) {
+			t.Fatalf("%q: %T %v", src, err, err)
+		}
+		if lines := strings.Count(src, "\n") + 1; cerr.Line < 1 || cerr.Line > lines {
+			t.Fatalf("%q: line %d out of %d", src, cerr.Line, lines)
+		}
+	})
+}
+
+func FuzzReadRecent(f *testing.F) {
+	for _, file := range []string{"RECENT-1h.json", "RECENT-1h.yaml", "RECENT-Z.json"} {
+		f.Add(readTestData(f, file))
+	}
+	f.Add([]byte(`{"meta":{"interval":"1h"},"recent":[{"epoch":1.5,"path":"a","type":"new"},{"epoch":"1e3","path":"b","type":"delete"}]}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		rf, err := ReadRecent(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+		for i, ev := range rf.Recent {
+			ev.Epoch.Time()
+			if i == 0 {
+				continue
+			}
+			prev := rf.Recent[i-1].Epoch
+			if c := ev.Epoch.Cmp(prev); c != -prev.Cmp(ev.Epoch) {
+				t.Fatalf("%q, %q: Cmp is not antisymmetric", ev.Epoch, prev)
+			}
+		}
+	})
+}
@@ -0,0 +1,439 @@
+// Package indexdb stores the CPAN indexes (02packages, 01mailrc, 06perms and
+// the CHECKSUMS of the authors directories) in an SQLite database, to query
+// them without loading them in memory.
+//
+// Imports are incremental: an index file that did not change since the
+// previous import is skipped, and only the CHECKSUMS files that changed are
+// read again.
+package indexdb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/openpgp"
+
+	"github.com/dolmen-go/CPAN"
+
+	_ "modernc.org/sqlite"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS meta (
+	name  TEXT PRIMARY KEY,
+	value TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS packages (
+	package TEXT PRIMARY KEY,
+	version TEXT NOT NULL,
+	path    TEXT NOT NULL,
+	author  TEXT NOT NULL,
+	gen     INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS packages_author ON packages (author);
+CREATE INDEX IF NOT EXISTS packages_path ON packages (path);
+CREATE TABLE IF NOT EXISTS authors (
+	id    TEXT PRIMARY KEY,
+	name  TEXT NOT NULL,
+	email TEXT NOT NULL,
+	gen   INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS perms (
+	package TEXT NOT NULL,
+	author  TEXT NOT NULL,
+	perm    TEXT NOT NULL,
+	gen     INTEGER NOT NULL,
+	PRIMARY KEY (package, author)
+);
+CREATE INDEX IF NOT EXISTS perms_author ON perms (author);
+CREATE TABLE IF NOT EXISTS checksums (
+	dir   TEXT PRIMARY KEY,
+	size  INTEGER NOT NULL,
+	mtime INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS files (
+	path   TEXT PRIMARY KEY,
+	dir    TEXT NOT NULL,
+	name   TEXT NOT NULL,
+	size   INTEGER NOT NULL,
+	mtime  TEXT NOT NULL,
+	md5    TEXT NOT NULL,
+	sha256 TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS files_dir ON files (dir);
+CREATE INDEX IF NOT EXISTS files_name ON files (name);
+CREATE INDEX IF NOT EXISTS files_sha256 ON files (sha256);
+CREATE INDEX IF NOT EXISTS files_md5 ON files (md5);
+`
+
+// Keys of the meta table: the fingerprint of the last file imported.
+const (
+	metaPackages = "02packages"
+	metaMailRC   = "01mailrc"
+	metaPerms    = "06perms"
+)
+
+// DB is an index store.
+type DB struct {
+	db *sql.DB
+	// KeyRing verifies the signature of the CHECKSUMS files imported by
+	// ImportCheckSumsTree. If nil, signatures are not verified, which is
+	// only suitable for trusted trees (a mirror that verifies the files,
+	// or a DarkPAN).
+	KeyRing openpgp.KeyRing
+}
+
+// Open opens the database in file, creating it if needed.
+func Open(file string) (*DB, error) {
+	db, err := sql.Open("sqlite", file)
+	if err != nil {
+		return nil, err
+	}
+	// A single connection avoids "database is locked" errors between
+	// readers and the writer, and ":memory:" databases being per connection
+	db.SetMaxOpenConns(1)
+	if _, err = db.Exec(schema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &DB{db: db}, nil
+}
+
+// Close closes the database.
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// inTx runs fn in a transaction, committed if fn returns no error.
+func (d *DB) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err = fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func getMeta(ctx context.Context, q interface {
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}, name string) (string, error) {
+	var value string
+	err := q.QueryRowContext(ctx, `SELECT value FROM meta WHERE name = ?`, name).Scan(&value)
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+	return value, err
+}
+
+func setMeta(ctx context.Context, tx *sql.Tx, name, value string) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO meta (name, value) VALUES (?, ?) ON CONFLICT (name) DO UPDATE SET value = excluded.value`, name, value)
+	return err
+}
+
+// nextGen returns the generation of the rows of an import in table: rows of
+// previous generations not seen during the import are then deleted.
+func nextGen(ctx context.Context, tx *sql.Tx, table string) (int64, error) {
+	var gen int64
+	err := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(gen), 0) + 1 FROM `+table).Scan(&gen)
+	return gen, err
+}
+
+// headerFingerprint identifies an index file by its header, which has the
+// date of generation.
+func headerFingerprint(header map[string][]string) string {
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, k := range keys {
+		for _, v := range header[k] {
+			b.WriteString(k + ": " + v + "\n")
+		}
+	}
+	return b.String()
+}
+
+// ImportPackagesIndex imports a 02packages.details.txt.gz file. The file is
+// skipped if its header is the same as the previously imported one; updated
+// is false in that case.
+func (d *DB) ImportPackagesIndex(ctx context.Context, r io.Reader) (updated bool, err error) {
+	header, entries, done := CPAN.ReadPackagesIndex(r)
+	skip := func(err error) (bool, error) {
+		// Drain to let the reader complete
+		for range entries {
+		}
+		if e := <-done; err == nil {
+			err = e
+		}
+		return false, err
+	}
+
+	fingerprint := headerFingerprint(header)
+	prev, err := getMeta(ctx, d.db, metaPackages)
+	if err != nil || header == nil || prev == fingerprint {
+		return skip(err)
+	}
+
+	err = d.inTx(ctx, func(tx *sql.Tx) error {
+		gen, err := nextGen(ctx, tx, "packages")
+		if err != nil {
+			_, err = skip(err)
+			return err
+		}
+		stmt, err := tx.PrepareContext(ctx, `INSERT INTO packages (package, version, path, author, gen) VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT (package) DO UPDATE SET version = excluded.version, path = excluded.path, author = excluded.author, gen = excluded.gen`)
+		if err != nil {
+			_, err = skip(err)
+			return err
+		}
+		defer stmt.Close()
+		for e := range entries {
+			if err == nil {
+				_, err = stmt.ExecContext(ctx, e.Package, e.Version, e.Path, e.Author(), gen)
+			}
+		}
+		// Don't commit a truncated index
+		if e := <-done; err == nil {
+			err = e
+		}
+		if err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(ctx, `DELETE FROM packages WHERE gen < ?`, gen); err != nil {
+			return err
+		}
+		return setMeta(ctx, tx, metaPackages, fingerprint)
+	})
+	return err == nil, err
+}
+
+// readAllHash reads r and returns its content and its sha256.
+func readAllHash(r io.Reader) ([]byte, string, error) {
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, "", err
+	}
+	h := sha256.Sum256(content)
+	return content, hex.EncodeToString(h[:]), nil
+}
+
+// ImportMailRC imports a 01mailrc.txt.gz file. The file is skipped if its
+// content is the same as the previously imported one; updated is false in
+// that case.
+func (d *DB) ImportMailRC(ctx context.Context, r io.Reader) (updated bool, err error) {
+	content, fingerprint, err := readAllHash(r)
+	if err != nil {
+		return false, err
+	}
+	prev, err := getMeta(ctx, d.db, metaMailRC)
+	if err != nil || prev == fingerprint {
+		return false, err
+	}
+	authors, err := CPAN.ReadMailRC(bytes.NewReader(content))
+	if err != nil {
+		return false, err
+	}
+
+	err = d.inTx(ctx, func(tx *sql.Tx) error {
+		gen, err := nextGen(ctx, tx, "authors")
+		if err != nil {
+			return err
+		}
+		stmt, err := tx.PrepareContext(ctx, `INSERT INTO authors (id, name, email, gen) VALUES (?, ?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET name = excluded.name, email = excluded.email, gen = excluded.gen`)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+		for _, a := range authors {
+			if _, err = stmt.ExecContext(ctx, a.ID, a.Name, a.Email, gen); err != nil {
+				return err
+			}
+		}
+		if _, err = tx.ExecContext(ctx, `DELETE FROM authors WHERE gen < ?`, gen); err != nil {
+			return err
+		}
+		return setMeta(ctx, tx, metaMailRC, fingerprint)
+	})
+	return err == nil, err
+}
+
+// ImportPerms imports a 06perms.txt.gz file. The file is skipped if its
+// header is the same as the previously imported one; updated is false in
+// that case.
+func (d *DB) ImportPerms(ctx context.Context, r io.Reader) (updated bool, err error) {
+	header, perms, err := CPAN.ReadPerms(r)
+	if err != nil {
+		return false, err
+	}
+	fingerprint := headerFingerprint(header)
+	prev, err := getMeta(ctx, d.db, metaPerms)
+	if err != nil || prev == fingerprint {
+		return false, err
+	}
+
+	err = d.inTx(ctx, func(tx *sql.Tx) error {
+		gen, err := nextGen(ctx, tx, "perms")
+		if err != nil {
+			return err
+		}
+		stmt, err := tx.PrepareContext(ctx, `INSERT INTO perms (package, author, perm, gen) VALUES (?, ?, ?, ?)
+			ON CONFLICT (package, author) DO UPDATE SET perm = excluded.perm, gen = excluded.gen`)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+		for _, p := range perms {
+			if _, err = stmt.ExecContext(ctx, p.Package, p.Author, p.Perm, gen); err != nil {
+				return err
+			}
+		}
+		if _, err = tx.ExecContext(ctx, `DELETE FROM perms WHERE gen < ?`, gen); err != nil {
+			return err
+		}
+		return setMeta(ctx, tx, metaPerms, fingerprint)
+	})
+	return err == nil, err
+}
+
+// ImportCheckSums replaces the files of the directory dir (relative to
+// authors/id, such as "D/DO/DOLMEN") with sums, the content of its
+// CHECKSUMS file, fetched with (*CPAN.Client).CheckSums for example.
+// Subdirectories are not recorded: they have their own CHECKSUMS.
+func (d *DB) ImportCheckSums(ctx context.Context, dir string, sums map[string]CPAN.CheckSum) error {
+	return d.inTx(ctx, func(tx *sql.Tx) error {
+		return importCheckSums(ctx, tx, dir, sums)
+	})
+}
+
+func importCheckSums(ctx context.Context, tx *sql.Tx, dir string, sums map[string]CPAN.CheckSum) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM files WHERE dir = ?`, dir); err != nil {
+		return err
+	}
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO files (path, dir, name, size, mtime, md5, sha256) VALUES (?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for name, sum := range sums {
+		if sum.IsDir != 0 {
+			continue
+		}
+		if _, err = stmt.ExecContext(ctx, dir+"/"+name, dir, name, sum.Size, sum.MTime, sum.MD5, sum.Sha256); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportCheckSumsTree imports the CHECKSUMS files found under root, the
+// authors/id directory of a local mirror. Only the CHECKSUMS files whose
+// size or modification time changed since the previous import are read.
+// Directories that have no CHECKSUMS file anymore are removed.
+//
+// The number of directories updated or removed is returned.
+func (d *DB) ImportCheckSumsTree(ctx context.Context, root string) (int, error) {
+	type state struct{ size, mtime int64 }
+	known := make(map[string]state)
+	rows, err := d.db.QueryContext(ctx, `SELECT dir, size, mtime FROM checksums`)
+	if err != nil {
+		return 0, err
+	}
+	for rows.Next() {
+		var dir string
+		var st state
+		if err = rows.Scan(&dir, &st.size, &st.mtime); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		known[dir] = st
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	seen := make(map[string]bool, len(known))
+	err = filepath.Walk(root, func(name string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() || fi.Name() != "CHECKSUMS" {
+			return ctx.Err()
+		}
+		rel, err := filepath.Rel(root, filepath.Dir(name))
+		if err != nil {
+			return err
+		}
+		dir := filepath.ToSlash(rel)
+		seen[dir] = true
+		st := state{fi.Size(), fi.ModTime().UnixNano()}
+		if prev, ok := known[dir]; ok && prev == st {
+			return nil
+		}
+
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		var sums map[string]CPAN.CheckSum
+		if d.KeyRing != nil {
+			sums, err = CPAN.ReadCheckSums(f, d.KeyRing)
+		} else {
+			sums, err = CPAN.ReadUnsignedCheckSums(f)
+		}
+		f.Close()
+		if err != nil {
+			return &os.PathError{Op: "read", Path: name, Err: err}
+		}
+
+		err = d.inTx(ctx, func(tx *sql.Tx) error {
+			if err := importCheckSums(ctx, tx, dir, sums); err != nil {
+				return err
+			}
+			_, err := tx.ExecContext(ctx, `INSERT INTO checksums (dir, size, mtime) VALUES (?, ?, ?)
+				ON CONFLICT (dir) DO UPDATE SET size = excluded.size, mtime = excluded.mtime`, dir, st.size, st.mtime)
+			return err
+		})
+		if err == nil {
+			count++
+		}
+		return err
+	})
+	if err != nil {
+		return count, err
+	}
+
+	for dir := range known {
+		if seen[dir] {
+			continue
+		}
+		err = d.inTx(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM files WHERE dir = ?`, dir); err != nil {
+				return err
+			}
+			_, err := tx.ExecContext(ctx, `DELETE FROM checksums WHERE dir = ?`, dir)
+			return err
+		})
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
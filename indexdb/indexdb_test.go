@@ -0,0 +1,289 @@
+package indexdb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+func openTemp(t *testing.T) *DB {
+	dir, err := ioutil.TempDir("", "indexdb-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	db, err := Open(filepath.Join(dir, "index.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func packagesIndex(t *testing.T, date string, entries ...*CPAN.PackagesIndexEntry) *bytes.Buffer {
+	var buf bytes.Buffer
+	err := CPAN.WritePackagesIndex(&buf, map[string][]string{"Last-Updated": {date}}, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+func gzipString(t *testing.T, s string) *bytes.Buffer {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	gz.Write([]byte(s))
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+func TestPackages(t *testing.T) {
+	ctx := context.Background()
+	db := openTemp(t)
+
+	if _, err := db.Package(ctx, "Foo::Bar"); err != CPAN.ErrNotFound {
+		t.Fatalf("got %v", err)
+	}
+
+	index := []*CPAN.PackagesIndexEntry{
+		{Package: "Foo::Bar", Version: "1.01", Path: "D/DO/DOLMEN/Foo-Bar-1.01.tar.gz"},
+		{Package: "Foo::Baz", Version: "undef", Path: "D/DO/DOLMEN/Foo-Bar-1.01.tar.gz"},
+		{Package: "Other", Version: "2", Path: "O/OT/OTHER/Other-2.tar.gz"},
+	}
+	updated, err := db.ImportPackagesIndex(ctx, packagesIndex(t, "day 1", index...))
+	if err != nil || !updated {
+		t.Fatalf("import: %v %v", updated, err)
+	}
+	updated, err = db.ImportPackagesIndex(ctx, packagesIndex(t, "day 1", index...))
+	if err != nil || updated {
+		t.Fatalf("same import: %v %v", updated, err)
+	}
+
+	e, err := db.Package(ctx, "Foo::Bar")
+	if err != nil || *e != *index[0] {
+		t.Errorf("got %v %v", e, err)
+	}
+	dists, err := db.DistsByAuthor(ctx, "DOLMEN")
+	if err != nil || !reflect.DeepEqual(dists, []string{"D/DO/DOLMEN/Foo-Bar-1.01.tar.gz"}) {
+		t.Errorf("got %v %v", dists, err)
+	}
+
+	index = []*CPAN.PackagesIndexEntry{
+		{Package: "Foo::Bar", Version: "1.02", Path: "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz"},
+		{Package: "Other", Version: "2", Path: "O/OT/OTHER/Other-2.tar.gz"},
+	}
+	updated, err = db.ImportPackagesIndex(ctx, packagesIndex(t, "day 2", index...))
+	if err != nil || !updated {
+		t.Fatalf("import: %v %v", updated, err)
+	}
+	if e = db.Lookup("Foo::Bar"); e == nil || *e != *index[0] {
+		t.Errorf("got %v", e)
+	}
+	if e = db.Lookup("Foo::Baz"); e != nil {
+		t.Errorf("Foo::Baz not removed: %v", e)
+	}
+	entries, err := db.PackagesOfDist(ctx, "O/OT/OTHER/Other-2.tar.gz")
+	if err != nil || len(entries) != 1 || *entries[0] != *index[1] {
+		t.Errorf("got %v %v", entries, err)
+	}
+
+	// A truncated index is not committed
+	buf := packagesIndex(t, "day 3")
+	if _, err = db.ImportPackagesIndex(ctx, bytes.NewReader(buf.Bytes()[:buf.Len()-10])); err == nil {
+		t.Error("error expected")
+	}
+	if e = db.Lookup("Foo::Bar"); e == nil {
+		t.Error("index truncated")
+	}
+}
+
+func TestAuthorsPerms(t *testing.T) {
+	ctx := context.Background()
+	db := openTemp(t)
+
+	mailrc := `alias DOLMEN "Olivier Mengué <dolmen@cpan.org>"
+alias OTHER "Someone Else <other@cpan.org>"
+`
+	for i, expected := range []bool{true, false} {
+		updated, err := db.ImportMailRC(ctx, gzipString(t, mailrc))
+		if err != nil || updated != expected {
+			t.Fatalf("import %d: %v %v", i, updated, err)
+		}
+	}
+	a, err := db.Author(ctx, "DOLMEN")
+	if err != nil || *a != (CPAN.Author{ID: "DOLMEN", Name: "Olivier Mengué", Email: "dolmen@cpan.org"}) {
+		t.Errorf("got %v %v", a, err)
+	}
+	if _, err = db.ImportMailRC(ctx, gzipString(t, "alias DOLMEN \"Olivier Mengué <dolmen@cpan.org>\"\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Author(ctx, "OTHER"); err != CPAN.ErrNotFound {
+		t.Errorf("OTHER not removed: %v", err)
+	}
+
+	perms := "File: 06perms.txt\nDate: day 1\n\nFoo::Bar,DOLMEN,f\nFoo::Bar,OTHER,c\n"
+	if _, err = db.ImportPerms(ctx, gzipString(t, perms)); err != nil {
+		t.Fatal(err)
+	}
+	list, err := db.Perms(ctx, "Foo::Bar")
+	expected := []*CPAN.Perm{
+		{Package: "Foo::Bar", Author: "DOLMEN", Perm: CPAN.PermFirstCome},
+		{Package: "Foo::Bar", Author: "OTHER", Perm: CPAN.PermCoMaint},
+	}
+	if err != nil || !reflect.DeepEqual(list, expected) {
+		t.Errorf("got %v %v", list, err)
+	}
+}
+
+func writeCheckSums(t *testing.T, root, dir string, sums map[string]CPAN.CheckSum, mtime time.Time) {
+	p := filepath.Join(root, filepath.FromSlash(dir), "CHECKSUMS")
+	if err := os.MkdirAll(filepath.Dir(p), 0777); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := CPAN.WriteCheckSums(&buf, sums); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(p, buf.Bytes(), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(p, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckSumsTree(t *testing.T) {
+	ctx := context.Background()
+	db := openTemp(t)
+	root, err := ioutil.TempDir("", "indexdb-tree-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	foo := CPAN.CheckSum{MD5: "aa", Sha256: "1111", Size: 10, MTime: "2021-01-01"}
+	bar := CPAN.CheckSum{MD5: "bb", Sha256: "2222", Size: 20, MTime: "2021-01-02"}
+	day1 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	writeCheckSums(t, root, "D/DO/DOLMEN", map[string]CPAN.CheckSum{
+		"Foo-1.0.tar.gz": foo,
+		"sub":            {IsDir: 1},
+	}, day1)
+	writeCheckSums(t, root, "O/OT/OTHER", map[string]CPAN.CheckSum{
+		"Bar-1.0.tar.gz": bar,
+		"Foo-1.0.tar.gz": foo,
+	}, day1)
+
+	n, err := db.ImportCheckSumsTree(ctx, root)
+	if err != nil || n != 2 {
+		t.Fatalf("import: %d %v", n, err)
+	}
+	files, err := db.FilesBySha256(ctx, "1111")
+	if err != nil || len(files) != 2 ||
+		files[0].Path != "D/DO/DOLMEN/Foo-1.0.tar.gz" || files[0].CheckSum != foo ||
+		files[1].Path != "O/OT/OTHER/Foo-1.0.tar.gz" {
+		t.Errorf("got %v %v", files, err)
+	}
+	if files, _ = db.FilesInDir(ctx, "D/DO/DOLMEN"); len(files) != 1 {
+		t.Errorf("directories must not be listed: %v", files)
+	}
+
+	n, err = db.ImportCheckSumsTree(ctx, root)
+	if err != nil || n != 0 {
+		t.Fatalf("unchanged import: %d %v", n, err)
+	}
+
+	writeCheckSums(t, root, "D/DO/DOLMEN", map[string]CPAN.CheckSum{
+		"Bar-2.0.tar.gz": bar,
+	}, day1.Add(time.Hour))
+	os.RemoveAll(filepath.Join(root, "O"))
+	n, err = db.ImportCheckSumsTree(ctx, root)
+	if err != nil || n != 2 {
+		t.Fatalf("import: %d %v", n, err)
+	}
+	if files, _ = db.FilesBySha256(ctx, "1111"); len(files) != 0 {
+		t.Errorf("got %v", files)
+	}
+	if files, _ = db.FilesBySha256(ctx, "2222"); len(files) != 1 || files[0].Path != "D/DO/DOLMEN/Bar-2.0.tar.gz" {
+		t.Errorf("got %v", files)
+	}
+}
+
+func TestLookupFile(t *testing.T) {
+	ctx := context.Background()
+	db := openTemp(t)
+
+	content := []byte("Foo-1.0 content")
+	sum, err := CPAN.ComputeCheckSum(bytes.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum.MTime = "2021-01-01"
+	other := CPAN.CheckSum{MD5: "bb", Sha256: "2222", Size: 20, MTime: "2021-01-02"}
+	if err = db.ImportCheckSums(ctx, "D/DO/DOLMEN", map[string]CPAN.CheckSum{
+		"Foo-1.0.tar.gz": sum,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err = db.ImportCheckSums(ctx, "E/EV/EVIL", map[string]CPAN.CheckSum{
+		"Foo-1.0.tar.gz": other,
+		"Copy.tar.gz":    {MD5: sum.MD5, Sha256: "3333", Size: sum.Size},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	local, _ := CPAN.ComputeCheckSum(bytes.NewReader(content))
+	l, err := db.LookupFile(ctx, "Foo-1.0.tar.gz", local)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l.Matches) != 1 || l.Matches[0].Path != "D/DO/DOLMEN/Foo-1.0.tar.gz" || l.Matches[0].MTime != "2021-01-01" || l.MD5Only {
+		t.Errorf("matches: %+v", l)
+	}
+	if len(l.Mismatches) != 1 || l.Mismatches[0].Path != "E/EV/EVIL/Foo-1.0.tar.gz" {
+		t.Errorf("mismatches: %+v", l.Mismatches)
+	}
+
+	// Same md5, other sha256: a collision, not a match
+	local.Sha256 = "4444"
+	if l, err = db.LookupFile(ctx, "x.tar.gz", local); err != nil {
+		t.Fatal(err)
+	}
+	if len(l.Matches) != 0 || l.MD5Only || len(l.Mismatches) != 0 {
+		t.Errorf("md5 collision: %+v", l)
+	}
+
+	// Old CHECKSUMS have only md5
+	if err = db.ImportCheckSums(ctx, "B/BA/BACKPAN", map[string]CPAN.CheckSum{
+		"Foo-1.0.tar.gz": {MD5: sum.MD5, Size: sum.Size},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if l, err = db.LookupFile(ctx, "Foo-1.0.tar.gz", local); err != nil {
+		t.Fatal(err)
+	}
+	if len(l.Matches) != 1 || l.Matches[0].Path != "B/BA/BACKPAN/Foo-1.0.tar.gz" || !l.MD5Only {
+		t.Errorf("md5: %+v", l)
+	}
+	if len(l.Mismatches) != 2 || l.Mismatches[0].Path != "D/DO/DOLMEN/Foo-1.0.tar.gz" || l.Mismatches[1].Path != "E/EV/EVIL/Foo-1.0.tar.gz" {
+		t.Errorf("md5: mismatches: %+v", l.Mismatches)
+	}
+
+	// The md5 of the upload without sha256 is compared
+	local.Sha256 = sum.Sha256
+	if l, err = db.LookupFile(ctx, "Foo-1.0.tar.gz", local); err != nil {
+		t.Fatal(err)
+	}
+	if len(l.Matches) != 1 || l.MD5Only || len(l.Mismatches) != 1 || l.Mismatches[0].Path != "E/EV/EVIL/Foo-1.0.tar.gz" {
+		t.Errorf("sha256 and md5: %+v", l)
+	}
+}
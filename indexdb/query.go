@@ -0,0 +1,203 @@
+package indexdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+// File is a file listed in the CHECKSUMS of an author directory.
+type File struct {
+	// Path is relative to authors/id, such as
+	// "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz".
+	Path string `json:"path"`
+	CPAN.CheckSum
+}
+
+// Package returns the entry of pkg in 02packages, or CPAN.ErrNotFound.
+func (d *DB) Package(ctx context.Context, pkg string) (*CPAN.PackagesIndexEntry, error) {
+	e := CPAN.PackagesIndexEntry{Package: pkg}
+	err := d.db.QueryRowContext(ctx, `SELECT version, path FROM packages WHERE package = ?`, pkg).Scan(&e.Version, &e.Path)
+	if err == sql.ErrNoRows {
+		return nil, CPAN.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// Lookup returns the entry of module in 02packages, or nil if it is not
+// indexed or on error. It implements resolver.Index.
+func (d *DB) Lookup(module string) *CPAN.PackagesIndexEntry {
+	e, _ := d.Package(context.Background(), module)
+	return e
+}
+
+// PackagesOfDist returns the entries of 02packages of the distribution
+// distPath, sorted by package.
+func (d *DB) PackagesOfDist(ctx context.Context, distPath string) ([]*CPAN.PackagesIndexEntry, error) {
+	rows, err := d.db.QueryContext(ctx, `SELECT package, version, path FROM packages WHERE path = ? ORDER BY package`, distPath)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var entries []*CPAN.PackagesIndexEntry
+	for rows.Next() {
+		var e CPAN.PackagesIndexEntry
+		if err = rows.Scan(&e.Package, &e.Version, &e.Path); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &e)
+	}
+	return entries, rows.Err()
+}
+
+// DistsByAuthor returns the paths of the distributions of the author (PAUSE
+// ID) that are in 02packages, sorted.
+func (d *DB) DistsByAuthor(ctx context.Context, id string) ([]string, error) {
+	return d.strings(ctx, `SELECT DISTINCT path FROM packages WHERE author = ? ORDER BY path`, id)
+}
+
+func (d *DB) strings(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []string
+	for rows.Next() {
+		var s string
+		if err = rows.Scan(&s); err != nil {
+			return nil, err
+		}
+		list = append(list, s)
+	}
+	return list, rows.Err()
+}
+
+// Author returns the author (PAUSE ID) from 01mailrc, or CPAN.ErrNotFound.
+func (d *DB) Author(ctx context.Context, id string) (*CPAN.Author, error) {
+	a := CPAN.Author{ID: id}
+	err := d.db.QueryRowContext(ctx, `SELECT name, email FROM authors WHERE id = ?`, id).Scan(&a.Name, &a.Email)
+	if err == sql.ErrNoRows {
+		return nil, CPAN.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// Perms returns the permissions on pkg from 06perms, sorted by author.
+func (d *DB) Perms(ctx context.Context, pkg string) ([]*CPAN.Perm, error) {
+	rows, err := d.db.QueryContext(ctx, `SELECT author, perm FROM perms WHERE package = ? ORDER BY author`, pkg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var perms []*CPAN.Perm
+	for rows.Next() {
+		p := CPAN.Perm{Package: pkg}
+		if err = rows.Scan(&p.Author, &p.Perm); err != nil {
+			return nil, err
+		}
+		perms = append(perms, &p)
+	}
+	return perms, rows.Err()
+}
+
+// FilesBySha256 returns the files of the CHECKSUMS with the given sha256
+// (in hexadecimal), sorted by path.
+func (d *DB) FilesBySha256(ctx context.Context, sha256 string) ([]*File, error) {
+	return d.files(ctx, `WHERE sha256 = ? ORDER BY path`, sha256)
+}
+
+// FilesByMD5 returns the files of the CHECKSUMS with the given md5 (in
+// hexadecimal), sorted by path.
+func (d *DB) FilesByMD5(ctx context.Context, md5 string) ([]*File, error) {
+	return d.files(ctx, `WHERE md5 = ? ORDER BY path`, md5)
+}
+
+// FilesByName returns the files of the CHECKSUMS with the given base name,
+// such as "Foo-Bar-1.02.tar.gz", sorted by path.
+func (d *DB) FilesByName(ctx context.Context, name string) ([]*File, error) {
+	return d.files(ctx, `WHERE name = ? ORDER BY path`, name)
+}
+
+// FileLookup is the result of LookupFile.
+type FileLookup struct {
+	// Matches are the uploads with the same content: same sha256, or, if no
+	// upload has the same sha256, same md5 among the uploads whose CHECKSUMS
+	// have no sha256 (MD5Only is then true).
+	Matches []*File `json:"matches"`
+	MD5Only bool    `json:"md5_only,omitempty"`
+	// Mismatches are the uploads with the same name but another content:
+	// the file is not the upload it claims to be.
+	Mismatches []*File `json:"mismatches,omitempty"`
+}
+
+// LookupFile finds the uploads that match a local file, given its base name
+// and its checksum computed with CPAN.ComputeCheckSum.
+//
+// An md5 is only compared with the uploads that have no sha256 in their
+// CHECKSUMS: a file with the md5 of an upload but another sha256 is not
+// that upload.
+func (d *DB) LookupFile(ctx context.Context, name string, sum CPAN.CheckSum) (*FileLookup, error) {
+	var l FileLookup
+	var err error
+	if sum.Sha256 != "" {
+		if l.Matches, err = d.FilesBySha256(ctx, sum.Sha256); err != nil {
+			return nil, err
+		}
+	}
+	if len(l.Matches) == 0 && sum.MD5 != "" {
+		if l.Matches, err = d.files(ctx, `WHERE md5 = ? AND sha256 = '' ORDER BY path`, sum.MD5); err != nil {
+			return nil, err
+		}
+		l.MD5Only = len(l.Matches) > 0
+	}
+	matched := make(map[string]bool, len(l.Matches))
+	for _, f := range l.Matches {
+		matched[f.Path] = true
+	}
+	sameName, err := d.FilesByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range sameName {
+		if matched[f.Path] {
+			continue
+		}
+		if f.Size != sum.Size ||
+			f.Sha256 != "" && f.Sha256 != sum.Sha256 ||
+			f.Sha256 == "" && f.MD5 != sum.MD5 {
+			l.Mismatches = append(l.Mismatches, f)
+		}
+	}
+	return &l, nil
+}
+
+// FilesInDir returns the files of the CHECKSUMS of dir (relative to
+// authors/id), sorted by path.
+func (d *DB) FilesInDir(ctx context.Context, dir string) ([]*File, error) {
+	return d.files(ctx, `WHERE dir = ? ORDER BY path`, dir)
+}
+
+func (d *DB) files(ctx context.Context, where string, args ...interface{}) ([]*File, error) {
+	rows, err := d.db.QueryContext(ctx, `SELECT path, size, mtime, md5, sha256 FROM files `+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var files []*File
+	for rows.Next() {
+		var f File
+		if err = rows.Scan(&f.Path, &f.Size, &f.MTime, &f.MD5, &f.Sha256); err != nil {
+			return nil, err
+		}
+		files = append(files, &f)
+	}
+	return files, rows.Err()
+}
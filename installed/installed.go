@@ -0,0 +1,361 @@
+// Package installed inventories the Perl modules installed in library
+// directories (the @INC of a perl) without running perl, like cpan-outdated
+// does with ExtUtils::Installed.
+//
+// The version of each module is extracted statically from its source with
+// CPAN.ScanPerlModule. The distributions are known from the .packlist files
+// of ExtUtils::MakeMaker and Module::Build under auto/, and from the
+// install.json and MYMETA.json files written by cpanm under .meta/.
+package installed
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dolmen-go/CPAN"
+	"github.com/dolmen-go/CPAN/resolver"
+)
+
+// Module is an installed module.
+type Module struct {
+	Name string `json:"name"`
+	// Version is empty if the module has no static $VERSION.
+	Version string `json:"version,omitempty"`
+	// File is the absolute path of the .pm file.
+	File string `json:"file"`
+	// Dist is the name of the distribution that installed the module, such
+	// as "Foo-Bar", if known.
+	Dist string `json:"dist,omitempty"`
+}
+
+// Dist is an installed distribution.
+type Dist struct {
+	// Name is the name of the distribution, such as "Foo-Bar".
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	// Pathname is the path of the release relative to authors/id, if known
+	// (only from cpanm's install.json).
+	Pathname string `json:"pathname,omitempty"`
+	// Modules are the modules installed by the distribution, sorted.
+	Modules []string `json:"modules"`
+}
+
+// Inventory is the list of modules and distributions installed.
+type Inventory struct {
+	Modules map[string]*Module `json:"modules"`
+	Dists   map[string]*Dist   `json:"dists"`
+}
+
+// reNamespace matches the directory names that may hold modules. Other
+// directories at the top of a library, such as the arch directory
+// ("x86_64-linux") or version directories ("5.36.0"), are libraries too.
+var reNamespace = regexp.MustCompile(`^[A-Za-z_]\w*$`)
+
+// Scan inventories the library directories dirs, in the order of @INC: a
+// module found in several directories is taken from the first.
+// Directories that do not exist are ignored.
+func Scan(dirs ...string) (*Inventory, error) {
+	inv := &Inventory{
+		Modules: make(map[string]*Module),
+		Dists:   make(map[string]*Dist),
+	}
+	sc := &scan{Inventory: inv, packlists: make(map[string]string)}
+	for _, dir := range dirs {
+		dir, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, err
+		}
+		if err = sc.lib(dir); err != nil {
+			return nil, err
+		}
+	}
+	// Distributions installed by cpanm
+	names := make([]string, 0, len(sc.metas))
+	for name := range sc.metas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		d := sc.metas[name]
+		inv.Dists[d.Name] = d
+		for _, module := range d.Modules {
+			if m := inv.Modules[module]; m != nil && m.Dist == "" {
+				m.Dist = d.Name
+			}
+		}
+		d.Modules = nil
+	}
+	for _, m := range inv.Modules {
+		if m.Dist == "" {
+			m.Dist = sc.packlists[m.File]
+		}
+		if m.Dist == "" {
+			continue
+		}
+		d := inv.Dists[m.Dist]
+		if d == nil {
+			d = &Dist{Name: m.Dist}
+			inv.Dists[m.Dist] = d
+		}
+		if !contains(d.Modules, m.Name) {
+			d.Modules = append(d.Modules, m.Name)
+		}
+	}
+	for _, d := range inv.Dists {
+		sort.Strings(d.Modules)
+		if d.Version == "" {
+			// The version of a distribution is the version of its main
+			// module
+			if m := inv.Modules[strings.Replace(d.Name, "-", "::", -1)]; m != nil {
+				d.Version = m.Version
+			}
+		}
+	}
+	return inv, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// scan is the state of Scan.
+type scan struct {
+	*Inventory
+	// packlists maps the files listed in .packlist files to their
+	// distribution
+	packlists map[string]string
+	// metas are the distributions found in .meta, with the modules they
+	// provide, by name
+	metas map[string]*Dist
+}
+
+// lib scans a library directory.
+func (sc *scan) lib(lib string) error {
+	entries, err := ioutil.ReadDir(lib)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, fi := range entries {
+		name := fi.Name()
+		p := filepath.Join(lib, name)
+		switch {
+		case !fi.IsDir():
+			if strings.HasSuffix(name, ".pm") {
+				sc.addModule(lib, p)
+			}
+		case name == "auto":
+			if err = sc.readPacklists(p); err != nil {
+				return err
+			}
+		case name == ".meta":
+			if err = sc.readMeta(p); err != nil {
+				return err
+			}
+		case reNamespace.MatchString(name):
+			err = filepath.Walk(p, func(p string, fi os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if !fi.IsDir() && strings.HasSuffix(p, ".pm") {
+					sc.addModule(lib, p)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		default:
+			if err = sc.lib(p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addModule adds the module in file, unless already found in a previous
+// library.
+func (sc *scan) addModule(lib, file string) {
+	rel, err := filepath.Rel(lib, file)
+	if err != nil {
+		return
+	}
+	name := strings.Replace(strings.TrimSuffix(filepath.ToSlash(rel), ".pm"), "/", "::", -1)
+	if _, ok := sc.Modules[name]; ok {
+		return
+	}
+	m := &Module{Name: name, File: file}
+	sc.Modules[name] = m
+
+	f, err := os.Open(file)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	pkgs, _ := CPAN.ScanPerlModule(f)
+	for _, p := range pkgs {
+		if p.Package == name {
+			m.Version = p.Version
+			break
+		}
+	}
+}
+
+// readPacklists reads the .packlist files under an auto directory.
+func (sc *scan) readPacklists(auto string) error {
+	return filepath.Walk(auto, func(p string, fi os.FileInfo, err error) error {
+		if err != nil || fi.IsDir() || fi.Name() != ".packlist" {
+			return err
+		}
+		rel, err := filepath.Rel(auto, filepath.Dir(p))
+		if err != nil {
+			return err
+		}
+		dist := strings.Replace(filepath.ToSlash(rel), "/", "-", -1)
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			// Lines are a file, optionally followed by key=value pairs
+			line := s.Text()
+			if i := strings.Index(line, " "); i >= 0 && strings.Contains(line[i:], "=") {
+				line = line[:i]
+			}
+			if line == "" {
+				continue
+			}
+			if _, ok := sc.packlists[line]; !ok {
+				sc.packlists[line] = dist
+			}
+		}
+		return s.Err()
+	})
+}
+
+// installJSON is the install.json written by cpanm.
+type installJSON struct {
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Dist     string `json:"dist"`
+	Pathname string `json:"pathname"`
+	Provides map[string]struct {
+		File    string `json:"file"`
+		Version string `json:"version"`
+	} `json:"provides"`
+}
+
+// readMeta reads the .meta directory written by cpanm: a directory per
+// release, with install.json and MYMETA.json.
+func (sc *scan) readMeta(meta string) error {
+	entries, err := ioutil.ReadDir(meta)
+	if err != nil {
+		return err
+	}
+	for _, fi := range entries {
+		if !fi.IsDir() {
+			continue
+		}
+		dir := filepath.Join(meta, fi.Name())
+		var d *Dist
+		var provides []string
+		if buf, err := ioutil.ReadFile(filepath.Join(dir, "install.json")); err == nil {
+			var inst installJSON
+			if json.Unmarshal(buf, &inst) == nil && inst.Pathname != "" {
+				name, version := (&CPAN.PackagesIndexEntry{Path: inst.Pathname}).Dist()
+				d = &Dist{Name: name, Version: version, Pathname: inst.Pathname}
+				for module := range inst.Provides {
+					provides = append(provides, module)
+				}
+			}
+		}
+		if d == nil {
+			f, err := os.Open(filepath.Join(dir, "MYMETA.json"))
+			if err != nil {
+				continue
+			}
+			m, err := CPAN.ReadMeta(f)
+			f.Close()
+			if err != nil || m.Name == "" {
+				continue
+			}
+			d = &Dist{Name: m.Name, Version: m.Version}
+			for module := range m.Provides {
+				provides = append(provides, module)
+			}
+		}
+		// The highest release wins
+		if prev := sc.metas[d.Name]; prev != nil && CPAN.CompareVersions(prev.Version, d.Version) > 0 {
+			continue
+		}
+		d.Modules = provides
+		if sc.metas == nil {
+			sc.metas = make(map[string]*Dist)
+		}
+		sc.metas[d.Name] = d
+	}
+	return nil
+}
+
+// Outdated is an installed module with a newer version in the index.
+type Outdated struct {
+	Module    string `json:"module"`
+	Installed string `json:"installed"`
+	Latest    string `json:"latest"`
+	// Path is the release that provides Latest in the index, relative to
+	// authors/id.
+	Path string `json:"path"`
+	// Dist is the installed distribution of the module, if known.
+	Dist string `json:"dist,omitempty"`
+}
+
+// Outdated returns the modules of inv that have a newer version in index
+// (a resolver.IndexMap, or an indexdb.DB), sorted by module.
+//
+// Modules without a static version are skipped, as are modules indexed
+// without version, or from perl itself.
+func (inv *Inventory) Outdated(index resolver.Index) []*Outdated {
+	var list []*Outdated
+	for _, m := range inv.Modules {
+		if m.Version == "" {
+			continue
+		}
+		e := index.Lookup(m.Name)
+		if e == nil || e.Version == "undef" {
+			continue
+		}
+		if dist, _ := e.Dist(); dist == "perl" {
+			continue
+		}
+		if CPAN.CompareVersions(e.Version, m.Version) > 0 {
+			list = append(list, &Outdated{
+				Module:    m.Name,
+				Installed: m.Version,
+				Latest:    e.Version,
+				Path:      e.Path,
+				Dist:      m.Dist,
+			})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Module < list[j].Module
+	})
+	return list
+}
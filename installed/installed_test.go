@@ -0,0 +1,104 @@
+package installed
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/dolmen-go/CPAN"
+	"github.com/dolmen-go/CPAN/resolver"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for name, content := range files {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	root, err := ioutil.TempDir("", "installed-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	lib := filepath.Join(root, "lib", "perl5")
+	writeFiles(t, root, map[string]string{
+		"lib/perl5/Foo/Bar.pm":         "package Foo::Bar;\nour $VERSION = '1.0';\n1;\n",
+		"lib/perl5/Foo/Bar/Util.pm":    "package Foo::Bar::Util;\n1;\n",
+		"lib/perl5/Baz.pm":             "package Baz;\nuse strict;\nour $VERSION = '2.0';\n1;\n",
+		"lib/perl5/Qux.pm":             "package Qux;\n$Qux::VERSION = '0.01';\n1;\n",
+		"lib/perl5/x86_64-linux/XS.pm": "package XS;\nour $VERSION = '3.1';\n1;\n",
+		"lib/perl5/x86_64-linux/.meta/Foo-Bar-1.0/install.json": `{"name":"Foo::Bar","target":"Foo::Bar","version":"1.0","dist":"Foo-Bar-1.0",` +
+			`"pathname":"D/DO/DOLMEN/Foo-Bar-1.0.tar.gz","provides":{"Foo::Bar":{"file":"lib/Foo/Bar.pm","version":"1.0"},"Foo::Bar::Util":{"file":"lib/Foo/Bar/Util.pm"}}}`,
+		"lib/perl5/x86_64-linux/auto/Baz/.packlist": filepath.Join(lib, "Baz.pm") + "\n" + filepath.Join(root, "bin", "baz") + " type=file\n",
+		"other/Foo/Bar.pm":                          "package Foo::Bar;\nour $VERSION = '0.5';\n1;\n",
+		"other/Other.pm":                            "package Other;\nour $VERSION = '1.0';\n1;\n",
+	})
+
+	inv, err := Scan(lib, filepath.Join(root, "other"), filepath.Join(root, "missing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	versions := make(map[string]string)
+	for name, m := range inv.Modules {
+		versions[name] = m.Version + " " + m.Dist
+	}
+	expected := map[string]string{
+		"Foo::Bar":       "1.0 Foo-Bar",
+		"Foo::Bar::Util": " Foo-Bar",
+		"Baz":            "2.0 Baz",
+		"Qux":            "0.01 ",
+		"XS":             "3.1 ",
+		"Other":          "1.0 ",
+	}
+	if !reflect.DeepEqual(versions, expected) {
+		t.Errorf("modules: got %q", versions)
+	}
+	if f := inv.Modules["Foo::Bar"].File; f != filepath.Join(lib, "Foo", "Bar.pm") {
+		t.Errorf("Foo::Bar from %s", f)
+	}
+
+	if d := inv.Dists["Foo-Bar"]; d == nil || !reflect.DeepEqual(*d, Dist{
+		Name:     "Foo-Bar",
+		Version:  "1.0",
+		Pathname: "D/DO/DOLMEN/Foo-Bar-1.0.tar.gz",
+		Modules:  []string{"Foo::Bar", "Foo::Bar::Util"},
+	}) {
+		t.Errorf("Foo-Bar: got %+v", d)
+	}
+	if d := inv.Dists["Baz"]; d == nil || !reflect.DeepEqual(*d, Dist{Name: "Baz", Version: "2.0", Modules: []string{"Baz"}}) {
+		t.Errorf("Baz: got %+v", d)
+	}
+	if len(inv.Dists) != 2 {
+		t.Errorf("dists: got %d", len(inv.Dists))
+	}
+
+	index := resolver.NewIndexMap([]*CPAN.PackagesIndexEntry{
+		{Package: "Foo::Bar", Version: "1.10", Path: "D/DO/DOLMEN/Foo-Bar-1.10.tar.gz"},
+		{Package: "Foo::Bar::Util", Version: "undef", Path: "D/DO/DOLMEN/Foo-Bar-1.10.tar.gz"},
+		{Package: "Baz", Version: "2.0", Path: "O/OT/OTHER/Baz-2.0.tar.gz"},
+		{Package: "Qux", Version: "0.02", Path: "O/OT/OTHER/Qux-0.02.tar.gz"},
+		{Package: "Other", Version: "0.9", Path: "O/OT/OTHER/Other-0.9.tar.gz"},
+		{Package: "XS", Version: "3.2", Path: "S/SH/SHAY/perl-5.36.0.tar.gz"},
+	})
+	outdated := inv.Outdated(index)
+	expectedOutdated := []*Outdated{
+		{Module: "Foo::Bar", Installed: "1.0", Latest: "1.10", Path: "D/DO/DOLMEN/Foo-Bar-1.10.tar.gz", Dist: "Foo-Bar"},
+		{Module: "Qux", Installed: "0.01", Latest: "0.02", Path: "O/OT/OTHER/Qux-0.02.tar.gz"},
+	}
+	if !reflect.DeepEqual(outdated, expectedOutdated) {
+		for _, o := range outdated {
+			t.Logf("%+v", o)
+		}
+		t.Error("outdated: unexpected result")
+	}
+}
@@ -0,0 +1,53 @@
+package CPAN
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Author is a PAUSE account, from 01mailrc.txt.gz.
+type Author struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+var reMailRC = regexp.MustCompile(`^alias\s+(\S+)\s+"(.*)"\s*$`)
+
+// ReadMailRC reads a 01mailrc.txt.gz file.
+//
+// The email is empty if the line has none (the name is "ID <CENSORED>" on
+// the real file for authors who hide their email, which is kept as is).
+func ReadMailRC(r io.Reader) ([]*Author, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	var authors []*Author
+	s := bufio.NewScanner(gz)
+	lineNum := 0
+	for s.Scan() {
+		lineNum++
+		line := s.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		m := reMailRC.FindStringSubmatch(line)
+		if m == nil {
+			return nil, fmt.Errorf("01mailrc:%d: invalid line", lineNum)
+		}
+		a := &Author{ID: m[1], Name: m[2]}
+		if i := strings.LastIndex(a.Name, " <"); i >= 0 && strings.HasSuffix(a.Name, ">") {
+			a.Name, a.Email = a.Name[:i], a.Name[i+2:len(a.Name)-1]
+		}
+		authors = append(authors, a)
+	}
+	if err = s.Err(); err != nil {
+		return nil, err
+	}
+	return authors, nil
+}
@@ -0,0 +1,40 @@
+package CPAN
+
+import (
+	"bytes"
+	"compress/gzip"
+	"reflect"
+	"testing"
+)
+
+func gzipString(t *testing.T, s string) *bytes.Buffer {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	gz.Write([]byte(s))
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+func TestReadMailRC(t *testing.T) {
+	authors, err := ReadMailRC(gzipString(t, `alias AADLER   "Andreas Adler <andreas.adler@gmx.de>"
+alias DOLMEN "Olivier Mengué <dolmen@cpan.org>"
+alias NOMAIL "No Mail"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []*Author{
+		{ID: "AADLER", Name: "Andreas Adler", Email: "andreas.adler@gmx.de"},
+		{ID: "DOLMEN", Name: "Olivier Mengué", Email: "dolmen@cpan.org"},
+		{ID: "NOMAIL", Name: "No Mail"},
+	}
+	if !reflect.DeepEqual(authors, expected) {
+		t.Errorf("got %+v", authors)
+	}
+
+	if _, err = ReadMailRC(gzipString(t, "alias FOO\n")); err == nil {
+		t.Error("error expected")
+	}
+}
@@ -0,0 +1,595 @@
+package CPAN
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Phases and relationships of prereqs, from CPAN::Meta::Spec.
+var (
+	MetaPhases        = []string{"configure", "build", "test", "runtime", "develop"}
+	MetaRelationships = []string{"requires", "recommends", "suggests", "conflicts"}
+)
+
+// MetaLicenses are the license values of CPAN::Meta::Spec version 2.
+var MetaLicenses = []string{
+	"agpl_3", "apache_1_1", "apache_2_0", "artistic_1", "artistic_2", "bsd",
+	"freebsd", "gfdl_1_2", "gfdl_1_3", "gpl_1", "gpl_2", "gpl_3", "lgpl_2_1",
+	"lgpl_3_0", "mit", "mozilla_1_0", "mozilla_1_1", "openssl", "perl_5",
+	"qpl_1_0", "ssleay", "sun", "zlib", "open_source", "restricted",
+	"unrestricted", "unknown",
+}
+
+// licenseV1 maps the license values of META.yml 1.x to version 2.
+var licenseV1 = map[string]string{
+	"apache":       "apache_2_0",
+	"artistic":     "artistic_1",
+	"artistic_2":   "artistic_2",
+	"bsd":          "bsd",
+	"gpl":          "open_source",
+	"lgpl":         "open_source",
+	"mit":          "mit",
+	"mozilla":      "open_source",
+	"open_source":  "open_source",
+	"perl":         "perl_5",
+	"restricted":   "restricted",
+	"unrestricted": "unrestricted",
+	"unknown":      "unknown",
+}
+
+var (
+	reModuleName = regexp.MustCompile(`^[A-Za-z_]\w*(?:::\w+)*$`)
+	reDistName   = regexp.MustCompile(`^[A-Za-z0-9_][\w-]*$`)
+)
+
+// Meta is the content of META.json or META.yml, in the form of
+// CPAN::Meta::Spec version 2. Files of version 1.x are upgraded by ReadMeta.
+type Meta struct {
+	Name             string                     `json:"name"`
+	Version          string                     `json:"version"`
+	Abstract         string                     `json:"abstract"`
+	Author           []string                   `json:"author"`
+	License          []string                   `json:"license"`
+	DynamicConfig    bool                       `json:"dynamic_config"`
+	ReleaseStatus    string                     `json:"release_status"`
+	GeneratedBy      string                     `json:"generated_by"`
+	MetaSpec         MetaSpec                   `json:"meta-spec"`
+	Keywords         []string                   `json:"keywords,omitempty"`
+	Description      string                     `json:"description,omitempty"`
+	Prereqs          Prereqs                    `json:"prereqs,omitempty"`
+	Provides         map[string]Provide         `json:"provides,omitempty"`
+	NoIndex          NoIndex                    `json:"no_index"`
+	Resources        Resources                  `json:"resources"`
+	OptionalFeatures map[string]OptionalFeature `json:"optional_features,omitempty"`
+
+	// noDynamicConfig is set when the decoded document has no
+	// dynamic_config, which is mandatory in version 2.
+	noDynamicConfig bool
+}
+
+// MetaSpec is the meta-spec field of Meta.
+type MetaSpec struct {
+	Version string `json:"version"`
+	URL     string `json:"url,omitempty"`
+}
+
+// Provide is an entry of the provides field of Meta.
+type Provide struct {
+	File    string `json:"file"`
+	Version string `json:"version,omitempty"`
+}
+
+// NoIndex lists what must not be indexed by PAUSE.
+type NoIndex struct {
+	File      []string `json:"file,omitempty"`
+	Directory []string `json:"directory,omitempty"`
+	Package   []string `json:"package,omitempty"`
+	Namespace []string `json:"namespace,omitempty"`
+}
+
+// Resources is the resources field of Meta.
+type Resources struct {
+	Homepage   string   `json:"homepage,omitempty"`
+	License    []string `json:"license,omitempty"`
+	Bugtracker struct {
+		Web    string `json:"web,omitempty"`
+		Mailto string `json:"mailto,omitempty"`
+	} `json:"bugtracker"`
+	Repository struct {
+		URL  string `json:"url,omitempty"`
+		Web  string `json:"web,omitempty"`
+		Type string `json:"type,omitempty"`
+	} `json:"repository"`
+}
+
+// OptionalFeature is an entry of the optional_features field of Meta.
+type OptionalFeature struct {
+	Description string  `json:"description"`
+	Prereqs     Prereqs `json:"prereqs"`
+}
+
+// Prereqs maps phases ("runtime", "test"...) and relationships
+// ("requires", "recommends"...) to requirements.
+type Prereqs map[string]map[string]Requirements
+
+// Requirements maps module names to version ranges, such as
+// ">= 1.2, < 2.0". See ParseVersionRange.
+type Requirements map[string]string
+
+// UnmarshalJSON accepts versions encoded as numbers.
+func (r *Requirements) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*r = make(Requirements, len(raw))
+	for module, v := range raw {
+		(*r)[module] = rawString(v)
+	}
+	return nil
+}
+
+// Add merges the requirement of range for module into r.
+func (r Requirements) Add(module, rng string) {
+	prev, ok := r[module]
+	switch {
+	case !ok || prev == "" || prev == "0":
+		r[module] = rng
+	case rng == "" || rng == "0" || rng == prev:
+	default:
+		r[module] = prev + ", " + rng
+	}
+}
+
+// Merge returns the requirements of the given relationship merged over
+// all the given phases. For example, the modules needed to run the tests
+// are p.Merge("requires", "runtime", "test").
+func (p Prereqs) Merge(relationship string, phases ...string) Requirements {
+	req := make(Requirements)
+	for _, phase := range phases {
+		for module, rng := range p[phase][relationship] {
+			req.Add(module, rng)
+		}
+	}
+	return req
+}
+
+func (p Prereqs) add(phase, relationship string, req Requirements) {
+	if len(req) == 0 {
+		return
+	}
+	rels := p[phase]
+	if rels == nil {
+		rels = make(map[string]Requirements)
+		p[phase] = rels
+	}
+	r := rels[relationship]
+	if r == nil {
+		r = make(Requirements)
+		rels[relationship] = r
+	}
+	for module, rng := range req {
+		r.Add(module, rng)
+	}
+}
+
+// UnmarshalJSON accepts the versions encoded as numbers and dynamic_config
+// as 0 or 1.
+func (m *Meta) UnmarshalJSON(b []byte) error {
+	type meta Meta
+	aux := struct {
+		*meta
+		Version       json.RawMessage `json:"version"`
+		DynamicConfig json.RawMessage `json:"dynamic_config"`
+	}{meta: (*meta)(m)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	m.Version = rawString(aux.Version)
+	m.DynamicConfig = rawBool(aux.DynamicConfig)
+	m.noDynamicConfig = aux.DynamicConfig == nil
+	return nil
+}
+
+// UnmarshalJSON accepts a version encoded as a number.
+func (s *MetaSpec) UnmarshalJSON(b []byte) error {
+	var aux struct {
+		Version json.RawMessage `json:"version"`
+		URL     string          `json:"url"`
+	}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	s.Version = rawString(aux.Version)
+	s.URL = aux.URL
+	return nil
+}
+
+// UnmarshalJSON accepts a version encoded as a number.
+func (p *Provide) UnmarshalJSON(b []byte) error {
+	var aux struct {
+		File    string          `json:"file"`
+		Version json.RawMessage `json:"version"`
+	}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	p.File = aux.File
+	p.Version = rawString(aux.Version)
+	return nil
+}
+
+// rawString returns a JSON string or number as a string.
+func rawString(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	return string(raw)
+}
+
+// rawBool returns a JSON boolean, or 0 or 1, as a bool.
+func rawBool(raw json.RawMessage) bool {
+	switch rawString(raw) {
+	case "", "0", "false":
+		return false
+	}
+	return true
+}
+
+// metaV1 is META.yml of CPAN::Meta::Spec 1.x.
+type metaV1 struct {
+	Name              string             `json:"name"`
+	Version           json.RawMessage    `json:"version"`
+	Abstract          string             `json:"abstract"`
+	Author            json.RawMessage    `json:"author"`
+	License           string             `json:"license"`
+	DynamicConfig     json.RawMessage    `json:"dynamic_config"`
+	GeneratedBy       string             `json:"generated_by"`
+	Keywords          []string           `json:"keywords"`
+	Requires          Requirements       `json:"requires"`
+	BuildRequires     Requirements       `json:"build_requires"`
+	ConfigureRequires Requirements       `json:"configure_requires"`
+	TestRequires      Requirements       `json:"test_requires"`
+	Recommends        Requirements       `json:"recommends"`
+	Conflicts         Requirements       `json:"conflicts"`
+	Provides          map[string]Provide `json:"provides"`
+	NoIndex           struct {
+		NoIndex
+		Dir []string `json:"dir"`
+	} `json:"no_index"`
+	Resources        map[string]json.RawMessage `json:"resources"`
+	OptionalFeatures map[string]struct {
+		Description       string       `json:"description"`
+		Requires          Requirements `json:"requires"`
+		BuildRequires     Requirements `json:"build_requires"`
+		ConfigureRequires Requirements `json:"configure_requires"`
+		Recommends        Requirements `json:"recommends"`
+		Conflicts         Requirements `json:"conflicts"`
+	} `json:"optional_features"`
+	MetaSpec MetaSpec `json:"meta-spec"`
+}
+
+func (m1 *metaV1) upgrade() *Meta {
+	m := &Meta{
+		Name:          m1.Name,
+		Version:       rawString(m1.Version),
+		Abstract:      m1.Abstract,
+		DynamicConfig: len(m1.DynamicConfig) == 0 || rawBool(m1.DynamicConfig),
+		GeneratedBy:   m1.GeneratedBy,
+		MetaSpec: MetaSpec{
+			Version: "2",
+			URL:     "http://search.cpan.org/perldoc?CPAN::Meta::Spec",
+		},
+		Keywords: m1.Keywords,
+		Prereqs:  make(Prereqs),
+		Provides: m1.Provides,
+		NoIndex:  m1.NoIndex.NoIndex,
+	}
+
+	var author []string
+	if json.Unmarshal(m1.Author, &author) != nil {
+		if a := rawString(m1.Author); a != "" {
+			author = []string{a}
+		}
+	}
+	m.Author = author
+
+	license, ok := licenseV1[m1.License]
+	if !ok {
+		license = "unknown"
+	}
+	m.License = []string{license}
+
+	if IsDevVersion(m.Version) {
+		m.ReleaseStatus = "testing"
+	} else {
+		m.ReleaseStatus = "stable"
+	}
+
+	m.Prereqs.add("runtime", "requires", m1.Requires)
+	m.Prereqs.add("runtime", "recommends", m1.Recommends)
+	m.Prereqs.add("runtime", "conflicts", m1.Conflicts)
+	m.Prereqs.add("build", "requires", m1.BuildRequires)
+	m.Prereqs.add("configure", "requires", m1.ConfigureRequires)
+	m.Prereqs.add("test", "requires", m1.TestRequires)
+
+	m.NoIndex.Directory = append(m.NoIndex.Directory, m1.NoIndex.Dir...)
+
+	for key, raw := range m1.Resources {
+		s := rawString(raw)
+		switch key {
+		case "homepage":
+			m.Resources.Homepage = s
+		case "license":
+			m.Resources.License = []string{s}
+		case "bugtracker":
+			m.Resources.Bugtracker.Web = s
+		case "repository":
+			m.Resources.Repository.URL = s
+		}
+	}
+
+	if len(m1.OptionalFeatures) > 0 {
+		m.OptionalFeatures = make(map[string]OptionalFeature, len(m1.OptionalFeatures))
+		for name, f := range m1.OptionalFeatures {
+			p := make(Prereqs)
+			p.add("runtime", "requires", f.Requires)
+			p.add("runtime", "recommends", f.Recommends)
+			p.add("runtime", "conflicts", f.Conflicts)
+			p.add("build", "requires", f.BuildRequires)
+			p.add("configure", "requires", f.ConfigureRequires)
+			m.OptionalFeatures[name] = OptionalFeature{Description: f.Description, Prereqs: p}
+		}
+	}
+	return m
+}
+
+// ReadMeta reads META.json or META.yml (or MYMETA.json, MYMETA.yml).
+// Both formats are detected from the content. Files of CPAN::Meta::Spec
+// version 1.x are upgraded to version 2, like CPAN::Meta::Converter does.
+func ReadMeta(r io.Reader) (*Meta, error) {
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMeta(buf)
+}
+
+// ParseMeta is like ReadMeta on a buffer.
+func ParseMeta(buf []byte) (*Meta, error) {
+	if trimmed := bytes.TrimSpace(buf); len(trimmed) == 0 || trimmed[0] != '{' {
+		var v yamlNode
+		if err := yaml.Unmarshal(buf, &v); err != nil {
+			return nil, err
+		}
+		var err error
+		if buf, err = json.Marshal(&v); err != nil {
+			return nil, err
+		}
+	}
+
+	var spec struct {
+		MetaSpec MetaSpec `json:"meta-spec"`
+	}
+	if err := json.Unmarshal(buf, &spec); err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(spec.MetaSpec.Version, "2") {
+		var m Meta
+		if err := json.Unmarshal(buf, &m); err != nil {
+			return nil, err
+		}
+		return &m, nil
+	}
+	var m1 metaV1
+	if err := json.Unmarshal(buf, &m1); err != nil {
+		return nil, err
+	}
+	return m1.upgrade(), nil
+}
+
+// yamlNode decodes YAML keeping the text of scalars: YAML 1.1 would read
+// unquoted versions such as 1.10 or 0.01_02 as floats.
+type yamlNode struct {
+	v interface{}
+}
+
+func (n *yamlNode) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var m map[string]*yamlNode
+	if unmarshal(&m) == nil {
+		n.v = m
+		return nil
+	}
+	var l []*yamlNode
+	if unmarshal(&l) == nil {
+		n.v = l
+		return nil
+	}
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	n.v = s
+	return nil
+}
+
+func (n *yamlNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.v)
+}
+
+// Validate checks m against CPAN::Meta::Spec version 2 and returns the
+// violations found, sorted.
+func (m *Meta) Validate() []error {
+	var msgs []string
+	errorf := func(format string, args ...interface{}) {
+		msgs = append(msgs, fmt.Sprintf(format, args...))
+	}
+
+	if m.MetaSpec.Version != "2" {
+		errorf("meta-spec: version %q is not 2", m.MetaSpec.Version)
+	}
+	switch {
+	case m.Name == "":
+		errorf("name: missing")
+	case strings.Contains(m.Name, "::"):
+		errorf("name: %q must use '-' instead of '::'", m.Name)
+	case !reDistName.MatchString(m.Name):
+		errorf("name: invalid %q", m.Name)
+	}
+	if m.Version == "" {
+		errorf("version: missing")
+	} else if !IsLaxVersion(m.Version) {
+		errorf("version: invalid %q", m.Version)
+	}
+	if m.noDynamicConfig {
+		errorf("dynamic_config: missing")
+	}
+	if m.Abstract == "" {
+		errorf("abstract: missing")
+	}
+	if len(m.Author) == 0 {
+		errorf("author: missing")
+	}
+	if m.GeneratedBy == "" {
+		errorf("generated_by: missing")
+	}
+	if len(m.License) == 0 {
+		errorf("license: missing")
+	}
+	for _, l := range m.License {
+		if !contains(MetaLicenses, l) {
+			errorf("license: unknown %q", l)
+		}
+	}
+	switch m.ReleaseStatus {
+	case "":
+		errorf("release_status: missing")
+	case "stable":
+		if strings.Contains(m.Version, "_") {
+			errorf("release_status: stable with developer version %q", m.Version)
+		}
+	case "testing", "unstable":
+	default:
+		errorf("release_status: invalid %q", m.ReleaseStatus)
+	}
+
+	m.Prereqs.validate("prereqs", errorf)
+	for name, f := range m.OptionalFeatures {
+		if f.Description == "" {
+			errorf("optional_features.%s: description missing", name)
+		}
+		f.Prereqs.validate("optional_features."+name+".prereqs", errorf)
+	}
+
+	for pkg, p := range m.Provides {
+		if !reModuleName.MatchString(pkg) {
+			errorf("provides: invalid package name %q", pkg)
+		}
+		if p.File == "" {
+			errorf("provides.%s: file missing", pkg)
+		}
+		if p.Version != "" && !IsLaxVersion(p.Version) {
+			errorf("provides.%s: invalid version %q", pkg, p.Version)
+		}
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+	sort.Strings(msgs)
+	errs := make([]error, len(msgs))
+	for i, msg := range msgs {
+		errs[i] = errors.New(msg)
+	}
+	return errs
+}
+
+func (p Prereqs) validate(field string, errorf func(string, ...interface{})) {
+	for phase, rels := range p {
+		if !contains(MetaPhases, phase) && !strings.HasPrefix(phase, "x_") {
+			errorf("%s: invalid phase %q", field, phase)
+		}
+		for rel, req := range rels {
+			if !contains(MetaRelationships, rel) && !strings.HasPrefix(rel, "x_") {
+				errorf("%s.%s: invalid relationship %q", field, phase, rel)
+			}
+			for module, rng := range req {
+				if !reModuleName.MatchString(module) {
+					errorf("%s.%s.%s: invalid module name %q", field, phase, rel, module)
+				}
+				if err := checkVersionRange(rng); err != nil {
+					errorf("%s.%s.%s.%s: %s", field, phase, rel, module, err)
+				}
+			}
+		}
+	}
+}
+
+// checkVersionRange is ParseVersionRange with the version of each
+// constraint checked by IsLaxVersion.
+func checkVersionRange(rng string) error {
+	if _, err := ParseVersionRange(rng); err != nil {
+		return err
+	}
+	for _, part := range strings.Split(rng, ",") {
+		v := strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(part), "<>=!"))
+		if v != "" && !IsLaxVersion(v) {
+			return fmt.Errorf("invalid version range %q: %s", rng, ErrInvalidVersion)
+		}
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// SkipsFile reports whether the file (a path relative to the top-level
+// directory of the distribution) is excluded from indexing by n.
+func (n *NoIndex) SkipsFile(file string) bool {
+	for _, f := range n.File {
+		if file == strings.TrimPrefix(f, "./") {
+			return true
+		}
+	}
+	for _, dir := range n.Directory {
+		dir = strings.TrimSuffix(strings.TrimPrefix(dir, "./"), "/")
+		if dir != "" && strings.HasPrefix(file, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// SkipsPackage reports whether the package is excluded from indexing by n.
+func (n *NoIndex) SkipsPackage(pkg string) bool {
+	for _, p := range n.Package {
+		if pkg == p {
+			return true
+		}
+	}
+	for _, ns := range n.Namespace {
+		if strings.HasPrefix(pkg, strings.TrimSuffix(ns, "::")+"::") {
+			return true
+		}
+	}
+	return false
+}
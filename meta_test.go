@@ -0,0 +1,234 @@
+package CPAN
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadMeta(t *testing.T) {
+	for _, name := range []string{"META-v2.json", "META-v1.4.yml"} {
+		f, err := openTestdata(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m, err := ReadMeta(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if m.Name != "Foo-Bar" || m.Version != "1.02" || m.ReleaseStatus != "stable" {
+			t.Errorf("%s: got %s %s %s", name, m.Name, m.Version, m.ReleaseStatus)
+		}
+		if !reflect.DeepEqual(m.License, []string{"perl_5"}) {
+			t.Errorf("%s: license: %q", name, m.License)
+		}
+		if m.MetaSpec.Version != "2" {
+			t.Errorf("%s: meta-spec: %q", name, m.MetaSpec.Version)
+		}
+		if m.DynamicConfig {
+			t.Errorf("%s: dynamic_config", name)
+		}
+		if got := m.Prereqs["runtime"]["requires"]["Scalar::Util"]; got != "1.50" {
+			t.Errorf("%s: runtime requires Scalar::Util: %q", name, got)
+		}
+		if got := m.Prereqs["runtime"]["recommends"]["JSON::XS"]; got != ">= 3.0, < 5.0" {
+			t.Errorf("%s: runtime recommends JSON::XS: %q", name, got)
+		}
+		if got := m.Prereqs["configure"]["requires"]["ExtUtils::MakeMaker"]; got != "6.30" {
+			t.Errorf("%s: configure requires ExtUtils::MakeMaker: %q", name, got)
+		}
+		if p := m.Provides["Foo::Bar::Baz"]; p.Version != "0.5" || p.File != "lib/Foo/Bar/Baz.pm" {
+			t.Errorf("%s: provides: %+v", name, m.Provides)
+		}
+		if !reflect.DeepEqual(m.NoIndex.Package, []string{"Foo::Bar::Quux"}) {
+			t.Errorf("%s: no_index: %+v", name, m.NoIndex)
+		}
+		if m.Resources.Bugtracker.Web != "https://github.com/dolmen/p5-Foo-Bar/issues" {
+			t.Errorf("%s: bugtracker: %+v", name, m.Resources.Bugtracker)
+		}
+		if errs := m.Validate(); errs != nil {
+			t.Errorf("%s: %v", name, errs)
+		}
+	}
+}
+
+func TestMetaNumbers(t *testing.T) {
+	m, err := ParseMeta([]byte(`{
+  "name": "Foo",
+  "version": 1.10,
+  "dynamic_config": 1,
+  "meta-spec": {"version": 2},
+  "prereqs": {"runtime": {"requires": {"perl": 5.008}}},
+  "provides": {"Foo": {"file": "lib/Foo.pm", "version": 1.1}}
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Version != "1.10" || !m.DynamicConfig || m.MetaSpec.Version != "2" {
+		t.Errorf("got %+v", m)
+	}
+	if got := m.Prereqs["runtime"]["requires"]["perl"]; got != "5.008" {
+		t.Errorf("perl: %q", got)
+	}
+	if got := m.Provides["Foo"].Version; got != "1.1" {
+		t.Errorf("provides: %q", got)
+	}
+}
+
+func TestMetaValidateDynamicConfig(t *testing.T) {
+	const meta = `{
+  "name": "Foo",
+  "version": "1.0",
+  "abstract": "Foo",
+  "author": ["Olivier Mengué <dolmen@cpan.org>"],
+  "license": ["perl_5"],
+  "release_status": "stable",
+  "generated_by": "hand",
+  %s
+  "meta-spec": {"version": 2}
+}`
+	for _, test := range []struct {
+		field string
+		errs  []string
+	}{
+		{`"dynamic_config": 0,`, nil},
+		{``, []string{"dynamic_config: missing"}},
+	} {
+		m, err := ParseMeta([]byte(fmt.Sprintf(meta, test.field)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, err := range m.Validate() {
+			got = append(got, err.Error())
+		}
+		if !reflect.DeepEqual(got, test.errs) {
+			t.Errorf("%q: got %q, expected %q", test.field, got, test.errs)
+		}
+	}
+}
+
+func TestMetaUpgrade(t *testing.T) {
+	m, err := ParseMeta([]byte(`---
+name: Foo-Bar
+version: 0.01_02
+author: Someone
+license: gpl
+requires:
+  Moo: 2
+test_requires:
+  Test::More: 0.98
+no_index:
+  dir: [t]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Version != "0.01_02" || m.ReleaseStatus != "testing" {
+		t.Errorf("version: %q, release_status: %q", m.Version, m.ReleaseStatus)
+	}
+	if !reflect.DeepEqual(m.Author, []string{"Someone"}) {
+		t.Errorf("author: %q", m.Author)
+	}
+	if !reflect.DeepEqual(m.License, []string{"open_source"}) {
+		t.Errorf("license: %q", m.License)
+	}
+	if m.Prereqs["runtime"]["requires"]["Moo"] != "2" || m.Prereqs["test"]["requires"]["Test::More"] != "0.98" {
+		t.Errorf("prereqs: %v", m.Prereqs)
+	}
+	if !reflect.DeepEqual(m.NoIndex.Directory, []string{"t"}) {
+		t.Errorf("no_index: %+v", m.NoIndex)
+	}
+}
+
+func TestMetaValidate(t *testing.T) {
+	m := &Meta{
+		Name:          "Foo::Bar",
+		Version:       "1.00_01",
+		ReleaseStatus: "stable",
+		License:       []string{"perl"},
+		MetaSpec:      MetaSpec{Version: "2"},
+		Provides: map[string]Provide{
+			"Foo::Bar": {File: "lib/Foo/Bar.pm", Version: "1.0foo"},
+		},
+		Prereqs: Prereqs{
+			"runtime": {"requires": {"Foo": ">= junk", "Baz": "1.0foo", "Quux": ">= 0, < 2.0foo"}},
+			"install": {"wants": {"Bar": "0"}},
+		},
+	}
+	var got []string
+	for _, err := range m.Validate() {
+		got = append(got, err.Error())
+	}
+	for _, expected := range []string{
+		"abstract: missing",
+		"author: missing",
+		"generated_by: missing",
+		`license: unknown "perl"`,
+		`name: "Foo::Bar" must use '-' instead of '::'`,
+		`prereqs: invalid phase "install"`,
+		`prereqs.runtime.requires.Baz: invalid version range "1.0foo": invalid version`,
+		`prereqs.runtime.requires.Quux: invalid version range ">= 0, < 2.0foo": invalid version`,
+		`provides.Foo::Bar: invalid version "1.0foo"`,
+		`prereqs.install: invalid relationship "wants"`,
+		`release_status: stable with developer version "1.00_01"`,
+	} {
+		found := false
+		for _, e := range got {
+			if e == expected {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("missing %q in:\n%s", expected, strings.Join(got, "\n"))
+		}
+	}
+	found := false
+	for _, e := range got {
+		if strings.HasPrefix(e, "prereqs.runtime.requires.Foo: ") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("invalid range not reported:\n%s", strings.Join(got, "\n"))
+	}
+}
+
+func TestVersionRange(t *testing.T) {
+	for _, test := range []struct {
+		rng      string
+		version  string
+		accepted bool
+	}{
+		{"0", "0.01", true},
+		{"", "junk", true},
+		{"1.50", "1.5", true},
+		{"1.50", "1.49", false},
+		{">= 3.0, < 5.0", "4.02", true},
+		{">= 3.0, < 5.0", "5.0", false},
+		{">= 3.0, != 4.0", "4", false},
+		{"== v1.2.3", "1.002003", true},
+		{"> 1.0", "1.0", false},
+		{"<= 1.0", "1.0", true},
+	} {
+		r, err := ParseVersionRange(test.rng)
+		if err != nil {
+			t.Errorf("%q: %s", test.rng, err)
+			continue
+		}
+		if got := r.AcceptsString(test.version); got != test.accepted {
+			t.Errorf("%q accepts %q: got %v", test.rng, test.version, got)
+		}
+	}
+	r, _ := ParseVersionRange(">=3.0,<5.0")
+	if got := r.String(); got != ">= 3.0, < 5.0" {
+		t.Errorf("String: %q", got)
+	}
+	for _, bad := range []string{">=", "junk", ">= 1, <"} {
+		if _, err := ParseVersionRange(bad); err == nil {
+			t.Errorf("%q: error expected", bad)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package mirror
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+func (m *Mirror) localPath(p string) string {
+	return filepath.Join(m.Dir, filepath.FromSlash(p))
+}
+
+func (m *Mirror) stat(p string) (os.FileInfo, error) {
+	return os.Stat(m.localPath(p))
+}
+
+func (m *Mirror) chtimes(p string, t time.Time) {
+	os.Chtimes(m.localPath(p), t, t)
+}
+
+// remove deletes the local file p. A missing file is not an error.
+func (m *Mirror) remove(p string) error {
+	err := os.Remove(m.localPath(p))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// verifyLocal checks that the local copy of p matches sum.
+func (m *Mirror) verifyLocal(p string, sum *CPAN.CheckSum) error {
+	f, err := os.Open(m.localPath(p))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return sum.Verify(f)
+}
+
+// writeFile installs the content of r as the local file p. The content goes
+// first to a temporary file in the same directory which is renamed once
+// complete, so readers of the mirror never see a partial file.
+// If sum is not nil, the content must match its size and sha256.
+func (m *Mirror) writeFile(p string, r io.Reader, sum *CPAN.CheckSum) error {
+	return CPAN.WriteFileAtomic(m.localPath(p), r, sum)
+}
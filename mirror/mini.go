@@ -0,0 +1,182 @@
+package mirror
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+// MiniIndexFiles are the index files mirrored by Mini.
+var MiniIndexFiles = []string{
+	CPAN.MailRCPath,
+	PackagesIndexPath,
+	"modules/03modlist.data.gz",
+}
+
+// Mini maintains a minimal mirror, like CPAN::Mini: only the index files
+// and the distributions referenced by 02packages.
+//
+// A distribution is mirrored if at least one of its packages is selected by
+// the filters.
+type Mini struct {
+	Mirror
+
+	// Modules, if not nil, selects packages by name.
+	Modules *regexp.Regexp
+	// SkipModules, if not nil, excludes packages by name.
+	SkipModules *regexp.Regexp
+	// Authors, if not empty, selects distributions by PAUSE ID of the author.
+	Authors []string
+	// SkipAuthors excludes distributions by PAUSE ID of the author.
+	SkipAuthors []string
+	// SkipCleanup disables the removal of the files under authors/id that
+	// are not referenced anymore.
+	SkipCleanup bool
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Mini) selected(e *CPAN.PackagesIndexEntry) bool {
+	if m.Modules != nil && !m.Modules.MatchString(e.Package) {
+		return false
+	}
+	if m.SkipModules != nil && m.SkipModules.MatchString(e.Package) {
+		return false
+	}
+	author := e.Author()
+	if len(m.Authors) > 0 && !containsString(m.Authors, author) {
+		return false
+	}
+	return !containsString(m.SkipAuthors, author)
+}
+
+// Sync refreshes the index files, downloads the selected distributions
+// that are missing locally and then removes unreferenced files.
+func (m *Mini) Sync(ctx context.Context) error {
+	m.checksumsMu.Lock()
+	m.checksums = nil
+	m.checksumsMu.Unlock()
+
+	for _, p := range MiniIndexFiles {
+		if _, err := m.refresh(ctx, p); err != nil {
+			return fmt.Errorf("%s: %s", p, err)
+		}
+	}
+
+	paths, err := m.referenced()
+	if err != nil {
+		return err
+	}
+	m.logf("%d distributions referenced", len(paths))
+
+	err = m.forEach(ctx, len(paths), func(i int) error {
+		p := paths[i]
+		if _, err := m.stat(p); err == nil {
+			return nil
+		}
+		sums, err := m.authorCheckSums(ctx, path.Dir(p), false)
+		if err == nil {
+			err = m.fetchVerified(ctx, p, sums)
+		}
+		if err != nil {
+			m.logf("%s: %s", p, err)
+			return fmt.Errorf("%s: %s", p, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if m.SkipCleanup {
+		return nil
+	}
+	return m.cleanup(paths)
+}
+
+// referenced returns the sorted list of the paths of the selected
+// distributions, relative to the mirror root.
+func (m *Mini) referenced() ([]string, error) {
+	f, err := os.Open(m.localPath(PackagesIndexPath))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	set := make(map[string]bool)
+	_, entries, done := CPAN.ReadPackagesIndex(bufio.NewReader(f))
+	for e := range entries {
+		if set[e.Path] || !m.selected(e) {
+			continue
+		}
+		set[e.Path] = true
+	}
+	if err = <-done; err != nil {
+		return nil, fmt.Errorf("%s: %s", PackagesIndexPath, err)
+	}
+
+	paths := make([]string, 0, len(set))
+	for p := range set {
+		p, err := cleanPath("authors/id/" + p)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// cleanup removes the files under authors/id that are not in paths (sorted).
+// The CHECKSUMS of the directories that still hold a distribution are kept.
+func (m *Mini) cleanup(paths []string) error {
+	dirs := make(map[string]bool)
+	for _, p := range paths {
+		dirs[path.Dir(p)] = true
+	}
+
+	root := m.localPath("authors/id")
+	return filepath.Walk(root, func(name string, fi os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(m.Dir, name)
+		if err != nil {
+			return err
+		}
+		p := filepath.ToSlash(rel)
+		if strings.HasPrefix(path.Base(p), ".tmp-") {
+			// Download in progress
+			return nil
+		}
+		if path.Base(p) == "CHECKSUMS" && dirs[path.Dir(p)] {
+			return nil
+		}
+		if i := sort.SearchStrings(paths, p); i < len(paths) && paths[i] == p {
+			return nil
+		}
+		m.logf("remove %s", p)
+		return os.Remove(name)
+	})
+}
@@ -0,0 +1,81 @@
+package mirror
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"golang.org/x/crypto/openpgp"
+)
+
+// writePackagesIndex writes modules/02packages.details.txt.gz from
+// testdata/02packages.details.txt.
+func (u *upstream) writePackagesIndex() {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write(u.readTestData("02packages.details.txt"))
+	w.Close()
+	u.writeFile(PackagesIndexPath, buf.Bytes())
+}
+
+func TestMiniSync(t *testing.T) {
+	up := newUpstream(t)
+	defer os.RemoveAll(up.dir)
+
+	local, err := ioutil.TempDir("", "cpan-minicpan-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(local)
+
+	srv := httptest.NewServer(http.FileServer(http.Dir(up.dir)))
+	defer srv.Close()
+
+	up.writeFile("authors/01mailrc.txt.gz", up.readTestData("01mailrc.txt.gz"))
+	up.writeFile("modules/03modlist.data.gz", up.readTestData("03modlist.data.gz"))
+	up.writeDist("D/DO/DOLMEN", "Git-Sub-0.163320.tar.gz", "Git-Sub-0.163130.tar.gz", "Acme-Foo-1.0.tar.gz")
+	up.writeDist("M/MI/MIYAGAWA", "cpan-outdated-0.31.tar.gz")
+	up.writePackagesIndex()
+
+	// A file left by a previous run, no longer referenced
+	stale := filepath.Join(local, "authors/id/D/DO/DOLMEN/Git-Sub-0.163130.tar.gz")
+	os.MkdirAll(filepath.Dir(stale), 0777)
+	ioutil.WriteFile(stale, up.readTestData("Git-Sub-0.163130.tar.gz"), 0644)
+
+	m := &Mini{
+		Mirror: Mirror{
+			Upstream: srv.URL,
+			Dir:      local,
+			KeyRing:  openpgp.EntityList{up.signer},
+			Logf:     t.Logf,
+		},
+		SkipModules: regexp.MustCompile(`^Acme::`),
+		SkipAuthors: []string{"MIYAGAWA"},
+	}
+	if err = m.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	for p, expected := range map[string]bool{
+		"authors/01mailrc.txt.gz":                            true,
+		PackagesIndexPath:                                    true,
+		"modules/03modlist.data.gz":                          true,
+		"authors/id/D/DO/DOLMEN/CHECKSUMS":                   true,
+		"authors/id/D/DO/DOLMEN/Git-Sub-0.163320.tar.gz":     true,
+		"authors/id/D/DO/DOLMEN/Git-Sub-0.163130.tar.gz":     false,
+		"authors/id/D/DO/DOLMEN/Acme-Foo-1.0.tar.gz":         false,
+		"authors/id/M/MI/MIYAGAWA/cpan-outdated-0.31.tar.gz": false,
+	} {
+		_, err := os.Stat(filepath.Join(local, filepath.FromSlash(p)))
+		if exists := err == nil; exists != expected {
+			t.Errorf("%s: exists=%v, expected %v", p, exists, expected)
+		}
+	}
+}
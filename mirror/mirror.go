@@ -0,0 +1,387 @@
+// Package mirror maintains a local copy of a CPAN mirror.
+//
+// Changes are discovered with the RECENT feeds of the upstream mirror and
+// every file under authors/id is verified against the signed CHECKSUMS of
+// its directory before being installed.
+package mirror
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/openpgp"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+// DefaultParallel is the default number of concurrent downloads.
+const DefaultParallel = 4
+
+// Mirror keeps the local directory Dir in sync with the Upstream CPAN mirror.
+type Mirror struct {
+	// Upstream is the base URL of the upstream mirror, such as
+	// "https://www.cpan.org/".
+	Upstream string
+	// Dir is the root of the local mirror.
+	Dir string
+	// Client is used for all requests. http.DefaultClient is used if nil.
+	Client *http.Client
+	// KeyRing verifies CHECKSUMS signatures. CPAN.PAUSEKeyRing is used if nil.
+	KeyRing openpgp.KeyRing
+	// Parallel is the maximum number of concurrent downloads.
+	// DefaultParallel is used if zero.
+	Parallel int
+	// Logf, if not nil, reports progress.
+	Logf func(format string, args ...interface{})
+
+	checksumsMu sync.Mutex
+	checksums   map[string]map[string]CPAN.CheckSum
+}
+
+// feeds are the directories of the upstream mirror that publish RECENT files.
+var feeds = []string{"authors", "modules"}
+
+// principal is the first file of each RECENT chain.
+const principal = "RECENT-1h.json"
+
+// PackagesIndexPath is the path of 02packages, relative to the mirror root.
+const PackagesIndexPath = CPAN.PackagesIndexPath
+
+// ErrNotFound is returned when the upstream mirror replies 404.
+var ErrNotFound = errors.New("not found")
+
+func (m *Mirror) logf(format string, args ...interface{}) {
+	if m.Logf != nil {
+		m.Logf(format, args...)
+	}
+}
+
+func (m *Mirror) client() *http.Client {
+	if m.Client != nil {
+		return m.Client
+	}
+	return http.DefaultClient
+}
+
+func (m *Mirror) keyRing() openpgp.KeyRing {
+	if m.KeyRing != nil {
+		return m.KeyRing
+	}
+	return CPAN.PAUSEKeyRing
+}
+
+func (m *Mirror) url(p string) string {
+	return strings.TrimRight(m.Upstream, "/") + "/" + p
+}
+
+// Sync fetches the changes published in the upstream RECENT feeds since the
+// previous run, and applies them to the local mirror. 02packages is refreshed
+// too.
+//
+// Changes that could not be applied (network errors, checksum mismatch...)
+// are recorded in the state file and retried by the next call to Sync.
+func (m *Mirror) Sync(ctx context.Context) error {
+	st, err := m.loadState()
+	if err != nil {
+		return err
+	}
+
+	m.checksumsMu.Lock()
+	m.checksums = nil
+	m.checksumsMu.Unlock()
+
+	for _, feed := range feeds {
+		fs := st.Feeds[feed]
+		if fs == nil {
+			fs = new(feedState)
+			st.Feeds[feed] = fs
+		}
+		open := m.recentOpener(ctx, feed)
+		events, dirtymark, err := CPAN.RecentChanges(open, principal, fs.Epoch)
+		if err != nil {
+			return fmt.Errorf("%s: %s", feed, err)
+		}
+		if fs.Dirtymark != "" && dirtymark != fs.Dirtymark && fs.Epoch != "" {
+			m.logf("%s: dirtymark changed, full resync", feed)
+			events, dirtymark, err = CPAN.RecentChanges(open, principal, "")
+			if err != nil {
+				return fmt.Errorf("%s: %s", feed, err)
+			}
+		}
+		m.logf("%s: %d changes", feed, len(events))
+		for _, ev := range events {
+			ev.Path = feed + "/" + ev.Path
+			st.addPending(ev)
+		}
+		fs.Dirtymark = dirtymark
+		if len(events) > 0 {
+			fs.Epoch = events[0].Epoch
+		}
+	}
+
+	// Record the new position in the feeds before touching any file, so
+	// an interrupted run resumes from the pending list
+	if err = m.saveState(st); err != nil {
+		return err
+	}
+
+	err = m.apply(ctx, st)
+
+	if e := m.saveState(st); err == nil {
+		err = e
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = m.refresh(ctx, PackagesIndexPath)
+	return err
+}
+
+// apply processes the pending events of st, concurrently: st.Pending has
+// only the latest event of each path, the only one to apply.
+// Events successfully applied are removed from st.Pending.
+func (m *Mirror) apply(ctx context.Context, st *state) error {
+	events := st.pendingEvents()
+	var mu sync.Mutex
+	return m.forEach(ctx, len(events), func(i int) error {
+		ev := events[i]
+		if err := m.applyEvent(ctx, ev); err != nil {
+			m.logf("%s %s: %s", ev.Type, ev.Path, err)
+			return fmt.Errorf("%s: %s", ev.Path, err)
+		}
+		mu.Lock()
+		delete(st.Pending, ev.Path)
+		mu.Unlock()
+		return nil
+	})
+}
+
+// forEach calls fn for each index in [0, n) with up to m.Parallel
+// concurrent calls. All items are processed even if some fail; the first
+// error is returned along with the count of failures.
+func (m *Mirror) forEach(ctx context.Context, n int, fn func(i int) error) error {
+	parallel := m.Parallel
+	if parallel <= 0 {
+		parallel = DefaultParallel
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		failures int
+		firstErr error
+	)
+	sem := make(chan struct{}, parallel)
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fn(i); err != nil {
+				mu.Lock()
+				failures++
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if failures > 1 {
+		return fmt.Errorf("%s (and %d other failures)", firstErr, failures-1)
+	}
+	return firstErr
+}
+
+func (m *Mirror) applyEvent(ctx context.Context, ev CPAN.RecentEvent) error {
+	p, err := cleanPath(ev.Path)
+	if err != nil {
+		return err
+	}
+	switch ev.Type {
+	case CPAN.RecentDelete:
+		m.logf("delete %s", p)
+		return m.remove(p)
+	case CPAN.RecentNew:
+		err = m.fetch(ctx, p)
+		if errors.Is(err, ErrNotFound) {
+			// Probably removed upstream since then: a delete event will follow
+			m.logf("skip %s: %s", p, err)
+			return nil
+		}
+		return err
+	default:
+		return fmt.Errorf("unknown event type %q", ev.Type)
+	}
+}
+
+// cleanPath rejects paths that would escape the mirror root.
+func cleanPath(p string) (string, error) {
+	c := path.Clean(p)
+	if c != p || path.IsAbs(c) || c == ".." || strings.HasPrefix(c, "../") {
+		return "", fmt.Errorf("invalid path %q", p)
+	}
+	return c, nil
+}
+
+// fetch installs the upstream file p in the local mirror.
+func (m *Mirror) fetch(ctx context.Context, p string) error {
+	if !strings.HasPrefix(p, "authors/id/") {
+		_, err := m.refresh(ctx, p)
+		return err
+	}
+
+	dir, name := path.Split(p)
+	dir = strings.TrimSuffix(dir, "/")
+	if name == "CHECKSUMS" {
+		_, err := m.authorCheckSums(ctx, dir, true)
+		return err
+	}
+
+	sums, err := m.authorCheckSums(ctx, dir, false)
+	if err != nil {
+		return err
+	}
+	return m.fetchVerified(ctx, p, sums)
+}
+
+// fetchVerified installs the upstream file p after checking its size and
+// sha256 against sums, the content of the CHECKSUMS of its directory.
+func (m *Mirror) fetchVerified(ctx context.Context, p string, sums map[string]CPAN.CheckSum) error {
+	sum, ok := sums[path.Base(p)]
+	if !ok {
+		return errors.New("not listed in CHECKSUMS")
+	}
+	if sum.IsDir != 0 {
+		return nil
+	}
+	if m.verifyLocal(p, &sum) == nil {
+		// Already there (resumed sync)
+		return nil
+	}
+	m.logf("fetch %s", p)
+	body, _, err := m.get(ctx, p, nil)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	return m.writeFile(p, body, &sum)
+}
+
+// authorCheckSums returns the verified content of dir/CHECKSUMS.
+// The upstream file is fetched once per sync, unless force is set, and
+// installed in the local mirror.
+func (m *Mirror) authorCheckSums(ctx context.Context, dir string, force bool) (map[string]CPAN.CheckSum, error) {
+	m.checksumsMu.Lock()
+	sums, ok := m.checksums[dir]
+	m.checksumsMu.Unlock()
+	if ok && !force {
+		return sums, nil
+	}
+
+	p := dir + "/CHECKSUMS"
+	body, _, err := m.get(ctx, p, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p, err)
+	}
+	defer body.Close()
+	var buf bytes.Buffer
+	if _, err = io.Copy(&buf, body); err != nil {
+		return nil, fmt.Errorf("%s: %w", p, err)
+	}
+	sums, err = CPAN.ReadCheckSums(bytes.NewReader(buf.Bytes()), m.keyRing())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p, err)
+	}
+	if err = m.writeFile(p, &buf, nil); err != nil {
+		return nil, err
+	}
+
+	m.checksumsMu.Lock()
+	if m.checksums == nil {
+		m.checksums = make(map[string]map[string]CPAN.CheckSum)
+	}
+	m.checksums[dir] = sums
+	m.checksumsMu.Unlock()
+	return sums, nil
+}
+
+// refresh installs the upstream file p unless the local copy is up to date.
+func (m *Mirror) refresh(ctx context.Context, p string) (updated bool, err error) {
+	h := make(http.Header)
+	if fi, err := m.stat(p); err == nil {
+		h.Set("If-Modified-Since", fi.ModTime().UTC().Format(http.TimeFormat))
+	}
+	body, header, err := m.get(ctx, p, h)
+	if err == errNotModified {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer body.Close()
+	m.logf("fetch %s", p)
+	if err = m.writeFile(p, body, nil); err != nil {
+		return false, err
+	}
+	if lm, err := http.ParseTime(header.Get("Last-Modified")); err == nil {
+		m.chtimes(p, lm)
+	}
+	return true, nil
+}
+
+var errNotModified = errors.New("not modified")
+
+// get sends a GET request for the upstream file p.
+func (m *Mirror) get(ctx context.Context, p string, h http.Header) (io.ReadCloser, http.Header, error) {
+	req, err := http.NewRequest("GET", m.url(p), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range h {
+		req.Header[k] = v
+	}
+	resp, err := m.client().Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, resp.Header, nil
+	case http.StatusNotModified:
+		err = errNotModified
+	case http.StatusNotFound:
+		err = ErrNotFound
+	default:
+		err = fmt.Errorf("HTTP status %s", resp.Status)
+	}
+	resp.Body.Close()
+	return nil, nil, err
+}
+
+// recentOpener returns a CPAN.RecentOpener that fetches the RECENT files of
+// the given feed from the upstream mirror.
+func (m *Mirror) recentOpener(ctx context.Context, feed string) CPAN.RecentOpener {
+	return func(name string) (io.ReadCloser, error) {
+		body, _, err := m.get(ctx, feed+"/"+name, nil)
+		return body, err
+	}
+}
@@ -0,0 +1,251 @@
+package mirror
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/clearsign"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+// upstream is a fake CPAN mirror for tests.
+type upstream struct {
+	t      *testing.T
+	dir    string
+	signer *openpgp.Entity
+	// events of the RECENT feeds, by feed
+	recent map[string][]CPAN.RecentEvent
+	epoch  int
+}
+
+func newUpstream(t *testing.T) *upstream {
+	dir, err := ioutil.TempDir("", "cpan-upstream-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := openpgp.NewEntity("PAUSE test", "", "pause@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &upstream{
+		t:      t,
+		dir:    dir,
+		signer: signer,
+		recent: make(map[string][]CPAN.RecentEvent),
+		epoch:  1479800000,
+	}
+}
+
+func (u *upstream) writeFile(p string, content []byte) {
+	dest := filepath.Join(u.dir, filepath.FromSlash(p))
+	if err := os.MkdirAll(filepath.Dir(dest), 0777); err != nil {
+		u.t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(dest, content, 0644); err != nil {
+		u.t.Fatal(err)
+	}
+}
+
+func (u *upstream) event(feed, p, typ string) {
+	u.epoch++
+	u.recent[feed] = append([]CPAN.RecentEvent{{
+		Epoch: CPAN.Epoch(fmt.Sprintf("%d.5", u.epoch)),
+		Path:  p,
+		Type:  typ,
+	}}, u.recent[feed]...)
+}
+
+// publish writes the RECENT files of all feeds: a 1h file with the latest
+// event and a Z file with everything.
+func (u *upstream) publish() {
+	for feed, events := range u.recent {
+		for _, f := range []struct {
+			interval string
+			events   []CPAN.RecentEvent
+		}{
+			{"1h", events[:1]},
+			{"Z", events},
+		} {
+			var rf CPAN.RecentFile
+			rf.Meta.Aggregator = []string{"Z"}
+			rf.Meta.Dirtymark = "1325155443.71674"
+			rf.Meta.FilenameRoot = "RECENT"
+			rf.Meta.Interval = f.interval
+			rf.Meta.SerializerSuffix = ".json"
+			rf.Meta.Minmax.Max = f.events[0].Epoch
+			rf.Meta.Minmax.Min = f.events[len(f.events)-1].Epoch
+			rf.Recent = f.events
+			buf, err := json.Marshal(&rf)
+			if err != nil {
+				u.t.Fatal(err)
+			}
+			u.writeFile(feed+"/RECENT-"+f.interval+".json", buf)
+		}
+	}
+}
+
+// readTestData returns the content of a file of testdata.
+func (u *upstream) readTestData(name string) []byte {
+	buf, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		u.t.Fatal(err)
+	}
+	return buf
+}
+
+// writeDist copies files from testdata to the author directory dir, and
+// writes the signed CHECKSUMS of the directory.
+func (u *upstream) writeDist(dir string, names ...string) {
+	sort.Strings(names)
+
+	var text bytes.Buffer
+	text.WriteString("# CHECKSUMS file for tests\n$cksum = {\n")
+	for i, name := range names {
+		if i > 0 {
+			text.WriteString(",\n")
+		}
+		content := u.readTestData(name)
+		s := sha256.Sum256(content)
+		m := md5.Sum(content)
+		fmt.Fprintf(&text, "  '%s' => {\n    'md5' => '%s',\n    'mtime' => '2016-11-27',\n    'sha256' => '%s',\n    'size' => %d\n  }",
+			name, hex.EncodeToString(m[:]), hex.EncodeToString(s[:]), len(content))
+		u.writeFile("authors/id/"+dir+"/"+name, content)
+	}
+	text.WriteString("\n};\n__END__\n")
+
+	var signed bytes.Buffer
+	w, err := clearsign.Encode(&signed, u.signer.PrivateKey, nil)
+	if err != nil {
+		u.t.Fatal(err)
+	}
+	w.Write(text.Bytes())
+	w.Close()
+	u.writeFile("authors/id/"+dir+"/CHECKSUMS", signed.Bytes())
+}
+
+func TestSync(t *testing.T) {
+	up := newUpstream(t)
+	defer os.RemoveAll(up.dir)
+
+	local, err := ioutil.TempDir("", "cpan-mirror-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(local)
+
+	srv := httptest.NewServer(http.FileServer(http.Dir(up.dir)))
+	defer srv.Close()
+
+	m := &Mirror{
+		Upstream: srv.URL,
+		Dir:      local,
+		KeyRing:  openpgp.EntityList{up.signer},
+		Parallel: 2,
+		Logf:     t.Logf,
+	}
+
+	exists := func(p string) bool {
+		_, err := os.Stat(filepath.Join(local, filepath.FromSlash(p)))
+		return err == nil
+	}
+
+	// Initial state
+	up.writeDist("D/DO/DOLMEN", "Foo-1.0.tar.gz")
+	up.writePackagesIndex()
+	up.event("authors", "id/D/DO/DOLMEN/Foo-1.0.tar.gz", CPAN.RecentNew)
+	up.event("authors", "id/D/DO/DOLMEN/CHECKSUMS", CPAN.RecentNew)
+	up.event("modules", "02packages.details.txt.gz", CPAN.RecentNew)
+	up.publish()
+
+	if err = m.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{
+		"authors/id/D/DO/DOLMEN/Foo-1.0.tar.gz",
+		"authors/id/D/DO/DOLMEN/CHECKSUMS",
+		PackagesIndexPath,
+		StateFile,
+	} {
+		if !exists(p) {
+			t.Errorf("%s: missing", p)
+		}
+	}
+
+	// Foo is replaced by Bar, but the Bar tarball is corrupted
+	bar := up.readTestData("Bar-2.0.tar.gz")
+	up.writeDist("D/DO/DOLMEN", "Bar-2.0.tar.gz")
+	up.writeFile("authors/id/D/DO/DOLMEN/Bar-2.0.tar.gz", []byte("Bar-2.0 tarbal!"))
+	os.Remove(filepath.Join(up.dir, "authors/id/D/DO/DOLMEN/Foo-1.0.tar.gz"))
+	up.event("authors", "id/D/DO/DOLMEN/Foo-1.0.tar.gz", CPAN.RecentDelete)
+	up.event("authors", "id/D/DO/DOLMEN/Bar-2.0.tar.gz", CPAN.RecentNew)
+	up.event("authors", "id/D/DO/DOLMEN/CHECKSUMS", CPAN.RecentNew)
+	up.publish()
+
+	if err = m.Sync(context.Background()); err == nil {
+		t.Fatal("corrupted file not detected")
+	} else {
+		t.Log(err)
+	}
+	if exists("authors/id/D/DO/DOLMEN/Foo-1.0.tar.gz") {
+		t.Error("Foo-1.0.tar.gz not deleted")
+	}
+	if exists("authors/id/D/DO/DOLMEN/Bar-2.0.tar.gz") {
+		t.Error("corrupted Bar-2.0.tar.gz installed")
+	}
+
+	// Upstream is fixed, without new events: the pending change is retried
+	up.writeFile("authors/id/D/DO/DOLMEN/Bar-2.0.tar.gz", bar)
+	if err = m.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile(filepath.Join(local, "authors/id/D/DO/DOLMEN/Bar-2.0.tar.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, bar) {
+		t.Errorf("Bar-2.0.tar.gz: got %q", got)
+	}
+
+	// Bar is deleted then added again: the latest event wins
+	os.Remove(filepath.Join(up.dir, "authors/id/D/DO/DOLMEN/Bar-2.0.tar.gz"))
+	up.event("authors", "id/D/DO/DOLMEN/Bar-2.0.tar.gz", CPAN.RecentDelete)
+	up.writeDist("D/DO/DOLMEN", "Bar-2.0.tar.gz")
+	up.event("authors", "id/D/DO/DOLMEN/Bar-2.0.tar.gz", CPAN.RecentNew)
+	up.publish()
+	if err = m.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !exists("authors/id/D/DO/DOLMEN/Bar-2.0.tar.gz") {
+		t.Error("Bar-2.0.tar.gz deleted")
+	}
+
+	// A release removed upstream with its author directory before the sync:
+	// the missing CHECKSUMS is skipped like a missing file
+	up.event("authors", "id/G/GO/GONE/Gone-1.0.tar.gz", CPAN.RecentNew)
+	up.publish()
+	if err = m.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := m.loadState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(st.Pending) != 0 {
+		t.Errorf("pending: %+v", st.Pending)
+	}
+}
@@ -0,0 +1,82 @@
+package mirror
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+// StateFile is the name of the file, at the root of the local mirror, that
+// records the progress of the synchronization.
+const StateFile = ".mirror-state.json"
+
+type feedState struct {
+	Dirtymark CPAN.Epoch `json:"dirtymark"`
+	// Epoch of the latest event already queued
+	Epoch CPAN.Epoch `json:"epoch"`
+}
+
+type state struct {
+	Feeds map[string]*feedState `json:"feeds"`
+	// Pending are the events not yet applied, by path. Only the latest
+	// event of a path is kept: it supersedes the previous ones.
+	Pending map[string]CPAN.RecentEvent `json:"pending"`
+}
+
+func (st *state) addPending(ev CPAN.RecentEvent) {
+	if prev, ok := st.Pending[ev.Path]; ok && prev.Epoch.Cmp(ev.Epoch) > 0 {
+		return
+	}
+	st.Pending[ev.Path] = ev
+}
+
+// pendingEvents returns the pending events, oldest first.
+func (st *state) pendingEvents() []CPAN.RecentEvent {
+	events := make([]CPAN.RecentEvent, 0, len(st.Pending))
+	for _, ev := range st.Pending {
+		events = append(events, ev)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if c := events[i].Epoch.Cmp(events[j].Epoch); c != 0 {
+			return c < 0
+		}
+		return events[i].Path < events[j].Path
+	})
+	return events
+}
+
+func (m *Mirror) loadState() (*state, error) {
+	st := &state{
+		Feeds:   make(map[string]*feedState),
+		Pending: make(map[string]CPAN.RecentEvent),
+	}
+	buf, err := ioutil.ReadFile(m.localPath(StateFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return st, nil
+		}
+		return nil, err
+	}
+	if err = json.Unmarshal(buf, st); err != nil {
+		return nil, err
+	}
+	if st.Feeds == nil {
+		st.Feeds = make(map[string]*feedState)
+	}
+	if st.Pending == nil {
+		st.Pending = make(map[string]CPAN.RecentEvent)
+	}
+	return st, nil
+}
+
+func (m *Mirror) saveState(st *state) error {
+	buf, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return err
+	}
+	return m.writeFile(StateFile, bytes.NewReader(buf), nil)
+}
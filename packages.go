@@ -5,8 +5,14 @@ import (
 	"bytes"
 	"compress/gzip"
 	"errors"
+	"fmt"
 	"io"
 	"net/textproto"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type PackagesIndexEntry struct {
@@ -15,70 +21,254 @@ type PackagesIndexEntry struct {
 	Path    string `json:"path"`
 }
 
+// Author returns the PAUSE ID of the author of the distribution, extracted
+// from Path.
+func (e *PackagesIndexEntry) Author() string {
+	parts := strings.SplitN(e.Path, "/", 4)
+	if len(parts) < 4 {
+		return ""
+	}
+	return parts[2]
+}
+
+// Dist returns the name and version of the distribution, extracted from
+// Path: "Foo-Bar" and "1.02" for "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz".
+func (e *PackagesIndexEntry) Dist() (name, version string) {
+	name = e.Path[strings.LastIndexByte(e.Path, '/')+1:]
+	for _, ext := range []string{".tar.gz", ".tgz", ".tar.bz2", ".tbz", ".zip"} {
+		if strings.HasSuffix(name, ext) {
+			name = name[:len(name)-len(ext)]
+			break
+		}
+	}
+	if m := reDistVersion.FindStringSubmatchIndex(name); m != nil {
+		return name[:m[0]], name[m[2]:m[3]]
+	}
+	return name, ""
+}
+
+var reDistVersion = regexp.MustCompile(`-(v?\d[\w.]*(?:-TRIAL)?)$`)
+
+// ComparePackageNames compares package names in the order of 02packages:
+// case-insensitive first. It returns -1, 0 or +1.
+func ComparePackageNames(a, b string) int {
+	if c := strings.Compare(strings.ToLower(a), strings.ToLower(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+// AuthorDir returns the directory of an author under authors/id, such as
+// "D/DO/DOLMEN" for "DOLMEN".
+func AuthorDir(id string) string {
+	if len(id) < 2 {
+		return id
+	}
+	return id[:1] + "/" + id[:2] + "/" + id
+}
+
+// ReadPackagesIndexBufferSize is the number of entries buffered by the
+// readers of the packages index ahead of the consumer.
 var ReadPackagesIndexBufferSize int = 4096
 
+// ErrReadAborted is the final error of a read of the packages index
+// aborted by its stop channel.
+var ErrReadAborted = errors.New("read aborted")
+
+// ReadPackagesIndex reads a 02packages.details.txt.gz file.
+//
+// The entries channel is closed after the last entry, then the final error
+// (nil on success) is sent on done. An invalid line stops reading with a
+// *ParseError. The entries must be read up to the end: use
+// ReadPackagesIndexStop to be able to stop early.
 func ReadPackagesIndex(r io.Reader) (
 	header map[string][]string,
 	entries <-chan *PackagesIndexEntry,
 	done chan error,
 ) {
-	done = make(chan error)
-	r, err := gzip.NewReader(r)
-	if err != nil {
-		done <- err
-		return nil, nil, done
-	}
-	headerR := textproto.NewReader(bufio.NewReader(r))
-	header, err = headerR.ReadMIMEHeader()
+	return readPackagesIndex(r, nil, false)
+}
+
+// ReadPackagesIndexStop is like ReadPackagesIndex, but reading is aborted
+// when the caller closes stop: the entries channel is then closed and the
+// final error is ErrReadAborted, unless reading had already ended.
+func ReadPackagesIndexStop(r io.Reader, stop <-chan struct{}) (
+	header map[string][]string,
+	entries <-chan *PackagesIndexEntry,
+	done chan error,
+) {
+	return readPackagesIndex(r, stop, false)
+}
+
+// ReadPackagesIndexLenient is like ReadPackagesIndex, but skips the invalid
+// lines: the final error is the ParseErrors of the invalid lines, unless
+// another error occurred.
+func ReadPackagesIndexLenient(r io.Reader) (
+	header map[string][]string,
+	entries <-chan *PackagesIndexEntry,
+	done chan error,
+) {
+	return readPackagesIndex(r, nil, true)
+}
+
+// readPackagesIndex implements the readers of the packages index. stop may
+// be nil.
+func readPackagesIndex(r io.Reader, stop <-chan struct{}, lenient bool) (
+	header map[string][]string,
+	entries <-chan *PackagesIndexEntry,
+	done chan error,
+) {
+	done = make(chan error, 1)
+	header, br, pos, err := readPackagesIndexHeader(r)
 	if err != nil {
-		done <- err
-		return nil, nil, done
+		return failPackagesIndex(done, err)
 	}
 
-	ent := make(chan *PackagesIndexEntry, 5)
+	ent := make(chan *PackagesIndexEntry, ReadPackagesIndexBufferSize)
 
 	go func() {
-		s := bufio.NewScanner(headerR.R)
-		headerR = nil
-	LOOP:
-		for s.Scan() {
-			var entry PackagesIndexEntry
-			line := s.Bytes()
-			i := bytes.IndexByte(line, ' ')
-			if i == -1 {
-				err = errors.New("invalid line: missing space separator")
-				break
-			}
-			if i == 0 {
-				err = errors.New("invalid line: no package")
-				break
-			}
-			entry.Package = string(line[:i])
-			j := bytes.LastIndexByte(line, ' ')
-			if j == len(line)-1 {
-				err = errors.New("invalid line: no dist")
-				break
+		err := scanPackagesLines(br, pos, lenient, func(pkg, version, path []byte) error {
+			entry := PackagesIndexEntry{
+				Package: string(pkg),
+				Version: string(version),
+				Path:    string(path),
 			}
-			entry.Path = string(line[j+1:])
-			// TODO check the DistPath format: no "/../"
-
-			entry.Version = string(bytes.Trim(line[i:j], " "))
-
 			select {
 			case ent <- &entry:
-			case _, cont := <-done:
-				if !cont {
-					break LOOP
-				}
-			default:
+				return nil
+			case <-stop:
+				return ErrReadAborted
 			}
-		}
-		if err == nil {
-			err = s.Err()
-		}
-		done <- err
+		})
 		close(ent)
+		done <- err
 	}()
 
 	return header, ent, done
 }
+
+// readPackagesIndexHeader decompresses a 02packages.details.txt.gz file and
+// reads its header. The returned reader is positioned on the first entry, at
+// pos.
+func readPackagesIndexHeader(r io.Reader) (header map[string][]string, br *bufio.Reader, pos packagesPosition, err error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, pos, err
+	}
+	br = bufio.NewReaderSize(gz, 64<<10)
+	// The header ends with an empty line
+	var buf []byte
+	pos.line = 1
+	for {
+		line, err := br.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			return nil, nil, pos, errors.New("header line too long")
+		}
+		buf = append(buf, line...)
+		pos.line++
+		pos.offset += int64(len(line))
+		if err != nil || len(bytes.TrimRight(line, "\r\n")) == 0 {
+			break
+		}
+	}
+	header, err = textproto.NewReader(bufio.NewReader(bytes.NewReader(buf))).ReadMIMEHeader()
+	if err != nil {
+		return nil, nil, pos, err
+	}
+	return header, br, pos, nil
+}
+
+func failPackagesIndex(done chan error, err error) (map[string][]string, <-chan *PackagesIndexEntry, chan error) {
+	ent := make(chan *PackagesIndexEntry)
+	close(ent)
+	done <- err
+	return nil, ent, done
+}
+
+// packagesIndexHeaderOrder is the order of the header fields written by PAUSE.
+var packagesIndexHeaderOrder = []string{
+	"File",
+	"Url",
+	"Description",
+	"Columns",
+	"Intended-For",
+	"Written-By",
+	"Line-Count",
+	"Last-Updated",
+}
+
+// WritePackagesIndex writes a 02packages.details.txt.gz file, gzipped, with
+// entries sorted like PAUSE does.
+//
+// Missing header fields get default values. Line-Count is always computed.
+func WritePackagesIndex(w io.Writer, header map[string][]string, entries []*PackagesIndexEntry) error {
+	h := make(textproto.MIMEHeader, len(header)+3)
+	for k, v := range header {
+		h[textproto.CanonicalMIMEHeaderKey(k)] = v
+	}
+	setDefault := func(k, v string) {
+		if _, ok := h[k]; !ok {
+			h.Set(k, v)
+		}
+	}
+	setDefault("File", "02packages.details.txt")
+	setDefault("Description", "Package names found in directory $CPAN/authors/id/")
+	setDefault("Columns", "package name, version, path")
+	setDefault("Intended-For", "Automated fetch routines, namespace documentation.")
+	setDefault("Written-By", "github.com/dolmen-go/CPAN")
+	setDefault("Last-Updated", time.Now().UTC().Format(time.RFC1123))
+	h.Set("Line-Count", strconv.Itoa(len(entries)))
+
+	sorted := make([]*PackagesIndexEntry, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return ComparePackageNames(sorted[i].Package, sorted[j].Package) < 0
+	})
+
+	gz := gzip.NewWriter(w)
+	bw := bufio.NewWriter(gz)
+
+	writeField := func(k string) {
+		for _, v := range h[k] {
+			name := k
+			if name == "Url" {
+				// Spelled like PAUSE does
+				name = "URL"
+			}
+			fmt.Fprintf(bw, "%-14s%s\n", name+":", v)
+		}
+		delete(h, k)
+	}
+	for _, k := range packagesIndexHeaderOrder {
+		writeField(k)
+	}
+	others := make([]string, 0, len(h))
+	for k := range h {
+		others = append(others, k)
+	}
+	sort.Strings(others)
+	for _, k := range others {
+		writeField(k)
+	}
+	bw.WriteByte('\n')
+
+	for _, e := range sorted {
+		version := e.Version
+		if version == "" {
+			version = "undef"
+		}
+		if strings.ContainsAny(e.Package, " \t\n") || strings.ContainsAny(version, " \t\n") || strings.ContainsAny(e.Path, " \t\n") {
+			return fmt.Errorf("invalid entry: %q %q %q", e.Package, version, e.Path)
+		}
+		if len(e.Package)+1+len(version) <= 39 {
+			fmt.Fprintf(bw, "%-30s %8s  %s\n", e.Package, version, e.Path)
+		} else {
+			fmt.Fprintf(bw, "%s %s  %s\n", e.Package, version, e.Path)
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
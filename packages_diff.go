@@ -0,0 +1,159 @@
+package CPAN
+
+import (
+	"fmt"
+	"io"
+)
+
+// Kinds of PackageDiff.
+const (
+	PackageAdded      = "added"
+	PackageRemoved    = "removed"
+	PackageUpgraded   = "upgraded"
+	PackageDowngraded = "downgraded"
+	// PackageUpdated is a change of path with the same version.
+	PackageUpdated = "updated"
+)
+
+// PackageDiff is the change of a package between two 02packages indexes.
+type PackageDiff struct {
+	Package string              `json:"package"`
+	Change  string              `json:"change"`
+	Old     *PackagesIndexEntry `json:"old,omitempty"`
+	New     *PackagesIndexEntry `json:"new,omitempty"`
+	// Moved is true if the package is provided by another distribution
+	// (not only another release of the same distribution).
+	Moved bool `json:"moved,omitempty"`
+	// AuthorChanged is true if the package is released by another author.
+	AuthorChanged bool `json:"author_changed,omitempty"`
+}
+
+func (d *PackageDiff) String() string {
+	var s string
+	switch d.Change {
+	case PackageAdded:
+		s = fmt.Sprintf("%-10s %s %s %s", d.Change, d.Package, d.New.Version, d.New.Path)
+	case PackageRemoved:
+		s = fmt.Sprintf("%-10s %s %s %s", d.Change, d.Package, d.Old.Version, d.Old.Path)
+	default:
+		s = fmt.Sprintf("%-10s %s %s -> %s %s", d.Change, d.Package, d.Old.Version, d.New.Version, d.New.Path)
+	}
+	if d.Moved {
+		oldDist, _ := d.Old.Dist()
+		s += " (moved from " + oldDist + ")"
+	}
+	if d.AuthorChanged {
+		s += " (author " + d.Old.Author() + " -> " + d.New.Author() + ")"
+	}
+	return s
+}
+
+// newPackageDiff returns the diff between two entries of the same package,
+// or nil if they are the same.
+func newPackageDiff(old, new *PackagesIndexEntry) *PackageDiff {
+	d := &PackageDiff{Old: old, New: new}
+	switch {
+	case old == nil:
+		d.Package = new.Package
+		d.Change = PackageAdded
+		return d
+	case new == nil:
+		d.Package = old.Package
+		d.Change = PackageRemoved
+		return d
+	}
+	d.Package = new.Package
+	switch cmp := CompareVersions(new.Version, old.Version); {
+	case cmp > 0:
+		d.Change = PackageUpgraded
+	case cmp < 0:
+		d.Change = PackageDowngraded
+	case new.Path == old.Path:
+		return nil
+	default:
+		d.Change = PackageUpdated
+	}
+	oldDist, _ := old.Dist()
+	newDist, _ := new.Dist()
+	d.Moved = oldDist != newDist
+	d.AuthorChanged = old.Author() != new.Author()
+	return d
+}
+
+// DiffPackagesIndex compares two 02packages.details.txt.gz files and calls
+// fn for each package that changed, in the order of the files.
+//
+// Both files are streamed: they must be sorted like PAUSE does (see
+// ComparePackageNames), or an error is returned. The iteration stops at the
+// first error returned by fn.
+func DiffPackagesIndex(old, new io.Reader, fn func(d *PackageDiff) error) error {
+	stop := make(chan struct{})
+	// Abort the readers on early return
+	defer close(stop)
+	_, oldEntries, oldDone := readPackagesIndex(old, stop, false)
+	_, newEntries, newDone := readPackagesIndex(new, stop, false)
+	return diffPackagesEntries(oldEntries, newEntries, oldDone, newDone, fn)
+}
+
+// diffPackagesEntries merges the entries of two readers. When the entries
+// of a reader end, its done error is checked before anything else is
+// reported: the end may be caused by an invalid line.
+func diffPackagesEntries(old, new <-chan *PackagesIndexEntry, oldDone, newDone <-chan error, fn func(d *PackageDiff) error) error {
+	next := func(ch <-chan *PackagesIndexEntry, done <-chan error, prev *PackagesIndexEntry, which string) (*PackagesIndexEntry, error) {
+		e, ok := <-ch
+		if !ok {
+			if err := <-done; err != nil {
+				return nil, fmt.Errorf("%s index: %w", which, err)
+			}
+			return nil, nil
+		}
+		if prev != nil && ComparePackageNames(prev.Package, e.Package) > 0 {
+			return nil, fmt.Errorf("%s index: not sorted: %s after %s", which, e.Package, prev.Package)
+		}
+		return e, nil
+	}
+
+	o, err := next(old, oldDone, nil, "old")
+	if err != nil {
+		return err
+	}
+	n, err := next(new, newDone, nil, "new")
+	if err != nil {
+		return err
+	}
+	for o != nil || n != nil {
+		var d *PackageDiff
+		var advanceOld, advanceNew bool
+		switch {
+		case n == nil:
+			d, advanceOld = newPackageDiff(o, nil), true
+		case o == nil:
+			d, advanceNew = newPackageDiff(nil, n), true
+		default:
+			switch ComparePackageNames(o.Package, n.Package) {
+			case -1:
+				d, advanceOld = newPackageDiff(o, nil), true
+			case 1:
+				d, advanceNew = newPackageDiff(nil, n), true
+			default:
+				d, advanceOld, advanceNew = newPackageDiff(o, n), true, true
+			}
+		}
+		if d != nil {
+			if err = fn(d); err != nil {
+				return err
+			}
+		}
+		if advanceOld {
+			if o, err = next(old, oldDone, o, "old"); err != nil {
+				return err
+			}
+		}
+		if advanceNew {
+			if n, err = next(new, newDone, n, "new"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
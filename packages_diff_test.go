@@ -0,0 +1,144 @@
+package CPAN
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeTestIndex(t *testing.T, lines ...string) *bytes.Buffer {
+	var entries []*PackagesIndexEntry
+	for i := 0; i < len(lines); i += 3 {
+		entries = append(entries, &PackagesIndexEntry{Package: lines[i], Version: lines[i+1], Path: lines[i+2]})
+	}
+	var buf bytes.Buffer
+	if err := WritePackagesIndex(&buf, nil, entries); err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+func TestDiffPackagesIndex(t *testing.T) {
+	old := writeTestIndex(t,
+		"Foo::Bar", "1.01", "D/DO/DOLMEN/Foo-Bar-1.01.tar.gz",
+		"Foo::Baz", "0.5", "D/DO/DOLMEN/Foo-Bar-1.01.tar.gz",
+		"Gone", "1", "A/AU/AUTHOR/Gone-1.tar.gz",
+		"Moved", "2.0", "D/DO/DOLMEN/Foo-Bar-1.01.tar.gz",
+		"Same", "1.10", "A/AU/AUTHOR/Same-1.10.tar.gz",
+		"Taken", "1.0", "A/AU/AUTHOR/Taken-1.0.tar.gz",
+	)
+	new := writeTestIndex(t,
+		"Added", "undef", "A/AU/AUTHOR/Added-0.01.tar.gz",
+		"Foo::Bar", "1.02", "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz",
+		"Foo::Baz", "0.4", "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz",
+		"Moved", "2.0", "D/DO/DOLMEN/Moved-2.0.tar.gz",
+		"Same", "1.1", "A/AU/AUTHOR/Same-1.10.tar.gz",
+		"Taken", "1.1", "O/OT/OTHER/Taken-1.1.tar.gz",
+	)
+	var got []string
+	err := DiffPackagesIndex(old, new, func(d *PackageDiff) error {
+		got = append(got, d.String())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"added      Added undef A/AU/AUTHOR/Added-0.01.tar.gz",
+		"upgraded   Foo::Bar 1.01 -> 1.02 D/DO/DOLMEN/Foo-Bar-1.02.tar.gz",
+		"downgraded Foo::Baz 0.5 -> 0.4 D/DO/DOLMEN/Foo-Bar-1.02.tar.gz",
+		"removed    Gone 1 A/AU/AUTHOR/Gone-1.tar.gz",
+		"updated    Moved 2.0 -> 2.0 D/DO/DOLMEN/Moved-2.0.tar.gz (moved from Foo-Bar)",
+		"upgraded   Taken 1.0 -> 1.1 O/OT/OTHER/Taken-1.1.tar.gz (author AUTHOR -> OTHER)",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got:\n%q", got)
+	}
+
+	// Stop early
+	old = writeTestIndex(t, "A", "1", "A/AU/AUTHOR/A-1.tar.gz", "B", "1", "A/AU/AUTHOR/B-1.tar.gz")
+	new = writeTestIndex(t)
+	errStop := errors.New("stop")
+	n := 0
+	err = DiffPackagesIndex(old, new, func(d *PackageDiff) error {
+		n++
+		return errStop
+	})
+	if err != errStop || n != 1 {
+		t.Errorf("got %v after %d", err, n)
+	}
+
+	// Stop early while the old index is still being received: the readers
+	// are aborted instead of being drained
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	go func() {
+		gz := gzip.NewWriter(pw)
+		gz.Write([]byte("File: 02packages.details.txt\n\nA 1 A/AU/AUTHOR/A-1.tar.gz\n"))
+		gz.Flush()
+		// The stream is never closed
+	}()
+	n = 0
+	err = DiffPackagesIndex(pr, writeTestIndex(t), func(d *PackageDiff) error {
+		n++
+		return errStop
+	})
+	if err != errStop || n != 1 {
+		t.Errorf("got %v after %d", err, n)
+	}
+
+	// Corrupt old index: the diff stops at the invalid line, without
+	// reporting the rest of the new index as added
+	n = 0
+	err = DiffPackagesIndex(
+		gzipString(t, "File: 02packages.details.txt\n\nA 1 A/AU/AUTHOR/A-1.tar.gz\nB:: 1 A/AU/AUTHOR/B-1.tar.gz\n"),
+		writeTestIndex(t,
+			"A", "1", "A/AU/AUTHOR/A-1.tar.gz",
+			"C", "1", "A/AU/AUTHOR/C-1.tar.gz",
+			"D", "1", "A/AU/AUTHOR/D-1.tar.gz",
+		),
+		func(d *PackageDiff) error {
+			n++
+			t.Errorf("unexpected diff: %s", d)
+			return nil
+		})
+	var perr *ParseError
+	if !errors.As(err, &perr) || perr.Line != 4 || !strings.HasPrefix(err.Error(), "old index: ") {
+		t.Errorf("corrupt index: got %v", err)
+	}
+	if n != 0 {
+		t.Errorf("corrupt index: got %d diffs", n)
+	}
+
+	// Entries differing only by case are sorted too
+	for _, text := range []string{
+		"B 1 A/AU/AUTHOR/B-1.tar.gz\nA 1 A/AU/AUTHOR/A-1.tar.gz\n",
+		"foo 1 A/AU/AUTHOR/Foo-1.tar.gz\nFoo 1 A/AU/AUTHOR/Foo-1.tar.gz\n",
+	} {
+		err = DiffPackagesIndex(gzipString(t, "File: 02packages.details.txt\n\n"+text), writeTestIndex(t), func(d *PackageDiff) error {
+			return nil
+		})
+		if err == nil || !strings.Contains(err.Error(), "not sorted") {
+			t.Errorf("%q: got error %v", text, err)
+		}
+	}
+}
+
+func TestPackagesIndexEntryDist(t *testing.T) {
+	for path, expected := range map[string][2]string{
+		"D/DO/DOLMEN/Foo-Bar-1.02.tar.gz":     {"Foo-Bar", "1.02"},
+		"D/DO/DOLMEN/Foo-Bar-v1.2.3.zip":      {"Foo-Bar", "v1.2.3"},
+		"D/DO/DOLMEN/Foo-Bar-1.02-TRIAL.tgz":  {"Foo-Bar", "1.02-TRIAL"},
+		"D/DO/DOLMEN/sub/Foo-0.01_01.tar.bz2": {"Foo", "0.01_01"},
+		"D/DO/DOLMEN/perl5.tar.gz":            {"perl5", ""},
+	} {
+		e := PackagesIndexEntry{Path: path}
+		if name, version := e.Dist(); name != expected[0] || version != expected[1] {
+			t.Errorf("%s: got %q %q", path, name, version)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package CPAN
+
+import (
+	"regexp"
+	"strings"
+)
+
+// PackagesFilter is a predicate on the entries of the packages index.
+//
+// Filters apply to the stream of ReadPackagesIndex with FilterPackages, or
+// to a slice, such as the input of WritePackagesIndex, with SelectPackages:
+//
+//	stop := make(chan struct{})
+//	_, entries, done := CPAN.ReadPackagesIndexStop(r, stop)
+//	for e := range CPAN.FilterPackages(stop, entries, CPAN.PackageNamespace("Acme"), CPAN.ExcludeDevReleases) {
+//		if ... {
+//			close(stop)
+//			break
+//		}
+//	}
+//	err := <-done // ErrReadAborted if stopped before the end
+//
+// Some filters, such as UniqueDists, keep a state: they must be created for
+// each stream.
+type PackagesFilter func(e *PackagesIndexEntry) bool
+
+// FilterPackages returns the entries of in that pass all the filters, in
+// order. The output is closed after the last entry of in, or as soon as
+// stop is closed: a consumer that stops reading early must close stop, which
+// must also stop the producer of in (see ReadPackagesIndexStop).
+func FilterPackages(stop <-chan struct{}, in <-chan *PackagesIndexEntry, filters ...PackagesFilter) <-chan *PackagesIndexEntry {
+	f := AllOf(filters...)
+	out := make(chan *PackagesIndexEntry, 5)
+	go func() {
+		defer close(out)
+		for {
+			var e *PackagesIndexEntry
+			var ok bool
+			select {
+			case e, ok = <-in:
+				if !ok {
+					return
+				}
+			case <-stop:
+				return
+			}
+			if !f(e) {
+				continue
+			}
+			select {
+			case out <- e:
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// SelectPackages returns the entries that pass all the filters, in order.
+func SelectPackages(entries []*PackagesIndexEntry, filters ...PackagesFilter) []*PackagesIndexEntry {
+	f := AllOf(filters...)
+	var selected []*PackagesIndexEntry
+	for _, e := range entries {
+		if f(e) {
+			selected = append(selected, e)
+		}
+	}
+	return selected
+}
+
+// AllOf returns a filter that passes the entries that pass all the filters.
+// Filters are evaluated in order, up to the first that rejects an entry.
+func AllOf(filters ...PackagesFilter) PackagesFilter {
+	return func(e *PackagesIndexEntry) bool {
+		for _, f := range filters {
+			if !f(e) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// AnyOf returns a filter that passes the entries that pass any of the
+// filters.
+func AnyOf(filters ...PackagesFilter) PackagesFilter {
+	return func(e *PackagesIndexEntry) bool {
+		for _, f := range filters {
+			if f(e) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not returns a filter that passes the entries that f rejects.
+func Not(f PackagesFilter) PackagesFilter {
+	return func(e *PackagesIndexEntry) bool {
+		return !f(e)
+	}
+}
+
+// PackageMatches passes the packages that match re.
+func PackageMatches(re *regexp.Regexp) PackagesFilter {
+	return func(e *PackagesIndexEntry) bool {
+		return re.MatchString(e.Package)
+	}
+}
+
+// PackageGlob passes the packages that match a glob pattern: "*" matches
+// any string, including "::", and "?" any character. "Acme::*" matches all
+// the packages under Acme::.
+func PackageGlob(pattern string) PackagesFilter {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return PackageMatches(regexp.MustCompile(b.String()))
+}
+
+// PackageNamespace passes the package ns and the packages under it:
+// "Acme" passes "Acme" and "Acme::Foo", but not "AcmeX".
+func PackageNamespace(ns string) PackagesFilter {
+	prefix := ns + "::"
+	return func(e *PackagesIndexEntry) bool {
+		return e.Package == ns || strings.HasPrefix(e.Package, prefix)
+	}
+}
+
+// ByAuthor passes the packages released by one of the authors (PAUSE
+// IDs), as given by PackagesIndexEntry.Author.
+func ByAuthor(ids ...string) PackagesFilter {
+	return func(e *PackagesIndexEntry) bool {
+		author := e.Author()
+		for _, id := range ids {
+			if author == id {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ExcludeDevReleases rejects the packages of developer releases (a version
+// of the distribution with an underscore or "-TRIAL") and the packages with
+// a developer version.
+func ExcludeDevReleases(e *PackagesIndexEntry) bool {
+	_, version := e.Dist()
+	return !IsDevVersion(version) && !IsDevVersion(e.Version)
+}
+
+// UniqueDists returns a filter that passes only the first entry of each
+// distribution file: the result lists each release once.
+func UniqueDists() PackagesFilter {
+	seen := make(map[string]bool)
+	return func(e *PackagesIndexEntry) bool {
+		if seen[e.Path] {
+			return false
+		}
+		seen[e.Path] = true
+		return true
+	}
+}
+
+// LatestDists returns the entries whose release is the latest release of
+// its distribution among entries: packages still indexed from an older
+// release of a distribution are dropped.
+//
+// Unlike filters, it needs all the entries, so it works only on a slice:
+// the 02packages index is sorted by package, and the packages of a release
+// are spread over the whole file. To apply it to a stream, collect the
+// entries first.
+func LatestDists(entries []*PackagesIndexEntry) []*PackagesIndexEntry {
+	latest := make(map[string]*PackagesIndexEntry)
+	for _, e := range entries {
+		name, version := e.Dist()
+		if prev := latest[name]; prev == nil {
+			latest[name] = e
+		} else if _, v := prev.Dist(); CompareVersions(version, v) > 0 {
+			latest[name] = e
+		}
+	}
+	return SelectPackages(entries, func(e *PackagesIndexEntry) bool {
+		name, _ := e.Dist()
+		return latest[name].Path == e.Path
+	})
+}
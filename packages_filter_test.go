@@ -0,0 +1,102 @@
+package CPAN
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+var testFilterEntries = []*PackagesIndexEntry{
+	{Package: "Acme", Version: "1.0", Path: "D/DO/DOLMEN/Acme-1.0.tar.gz"},
+	{Package: "Acme::Foo", Version: "1.0", Path: "D/DO/DOLMEN/Acme-1.0.tar.gz"},
+	{Package: "Acme::Foo::Bar", Version: "0.01_01", Path: "O/OT/OTHER/Acme-Foo-Bar-0.01_01.tar.gz"},
+	{Package: "Acme::Old", Version: "0.5", Path: "D/DO/DOLMEN/Acme-0.5.tar.gz"},
+	{Package: "AcmeX", Version: "2", Path: "O/OT/OTHER/AcmeX-2-TRIAL.tar.gz"},
+	{Package: "Other", Version: "3", Path: "O/OT/OTHER/Other-3.tar.gz"},
+}
+
+func packageNames(entries []*PackagesIndexEntry) []string {
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Package
+	}
+	return names
+}
+
+func TestPackagesFilters(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		filters  []PackagesFilter
+		expected []string
+	}{
+		{"namespace", []PackagesFilter{PackageNamespace("Acme")}, []string{"Acme", "Acme::Foo", "Acme::Foo::Bar", "Acme::Old"}},
+		{"glob", []PackagesFilter{PackageGlob("Acme*")}, []string{"Acme", "Acme::Foo", "Acme::Foo::Bar", "Acme::Old", "AcmeX"}},
+		{"glob ?", []PackagesFilter{PackageGlob("Acme::???")}, []string{"Acme::Foo", "Acme::Old"}},
+		{"regexp", []PackagesFilter{PackageMatches(regexp.MustCompile(`^Acme::\w+$`))}, []string{"Acme::Foo", "Acme::Old"}},
+		{"author", []PackagesFilter{ByAuthor("OTHER")}, []string{"Acme::Foo::Bar", "AcmeX", "Other"}},
+		{"dev", []PackagesFilter{ExcludeDevReleases}, []string{"Acme", "Acme::Foo", "Acme::Old", "Other"}},
+		{"unique", []PackagesFilter{UniqueDists()}, []string{"Acme", "Acme::Foo::Bar", "Acme::Old", "AcmeX", "Other"}},
+		{"and", []PackagesFilter{ByAuthor("OTHER"), ExcludeDevReleases}, []string{"Other"}},
+		{"or", []PackagesFilter{AnyOf(PackageNamespace("Other"), PackageGlob("*Old"))}, []string{"Acme::Old", "Other"}},
+		{"not", []PackagesFilter{Not(PackageGlob("Acme*"))}, []string{"Other"}},
+	} {
+		got := packageNames(SelectPackages(testFilterEntries, test.filters...))
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%s: got %q", test.name, got)
+		}
+	}
+
+	if got := packageNames(LatestDists(testFilterEntries)); !reflect.DeepEqual(got, []string{"Acme", "Acme::Foo", "Acme::Foo::Bar", "AcmeX", "Other"}) {
+		t.Errorf("latest: got %q", got)
+	}
+}
+
+func TestFilterPackagesStream(t *testing.T) {
+	// Round trip through the writer and the reader
+	var buf bytes.Buffer
+	entries := SelectPackages(testFilterEntries, ByAuthor("DOLMEN"))
+	if err := WritePackagesIndex(&buf, nil, entries); err != nil {
+		t.Fatal(err)
+	}
+
+	data := buf.Bytes()
+
+	_, ch, done := ReadPackagesIndex(bytes.NewReader(data))
+	var got []*PackagesIndexEntry
+	for e := range FilterPackages(nil, ch, PackageNamespace("Acme"), Not(PackageGlob("*Old"))) {
+		got = append(got, e)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(packageNames(got), []string{"Acme", "Acme::Foo"}) {
+		t.Errorf("got %q", packageNames(got))
+	}
+
+	// Stop after the first entry: the output and the reader are closed
+	stop := make(chan struct{})
+	_, ch, done = ReadPackagesIndexStop(bytes.NewReader(data), stop)
+	out := FilterPackages(stop, ch)
+	if e := <-out; e == nil {
+		t.Fatal("no entry")
+	}
+	close(stop)
+	for range out {
+	}
+	for range ch {
+	}
+	// The whole index may have been read already
+	if err := <-done; err != nil && err != ErrReadAborted {
+		t.Fatal(err)
+	}
+
+	// Stop while waiting for the input
+	in := make(chan *PackagesIndexEntry)
+	stop = make(chan struct{})
+	out = FilterPackages(stop, in)
+	close(stop)
+	if _, ok := <-out; ok {
+		t.Error("entry after stop")
+	}
+}
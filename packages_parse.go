@@ -0,0 +1,472 @@
+package CPAN
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+)
+
+// ParseError is an invalid line of a 02packages.details.txt.gz file.
+type ParseError struct {
+	// Line is the line number in the uncompressed file, header included,
+	// starting at 1.
+	Line int
+	// Offset is the offset of the start of the line in the uncompressed
+	// file.
+	Offset int64
+	// Text is the content of the line.
+	Text string
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d (offset %d): %s: %q", e.Line, e.Offset, e.Err, e.Text)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseErrors are the invalid lines found by ParsePackagesIndexLenient.
+type ParseErrors []*ParseError
+
+func (errs ParseErrors) Error() string {
+	if len(errs) == 1 {
+		return errs[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", errs[0], len(errs)-1)
+}
+
+var (
+	errMissingSeparator = errors.New("missing space separator")
+	errTooManyFields    = errors.New("too many fields")
+	errInvalidPackage   = errors.New("invalid package name")
+	errInvalidVersion   = errors.New("invalid version")
+	errInvalidPath      = errors.New("invalid path")
+)
+
+// splitPackagesLine splits a line of 02packages into its fields and checks
+// their syntax. The fields are slices of line.
+func splitPackagesLine(line []byte) (pkg, version, path []byte, err error) {
+	i := bytes.IndexByte(line, ' ')
+	if i == -1 {
+		return nil, nil, nil, errMissingSeparator
+	}
+	j := bytes.LastIndexByte(line, ' ')
+	pkg, version, path = line[:i], bytes.Trim(line[i:j], " "), line[j+1:]
+	switch {
+	case !validPackageName(pkg):
+		return nil, nil, nil, errInvalidPackage
+	case bytes.IndexByte(version, ' ') >= 0:
+		return nil, nil, nil, errTooManyFields
+	case !validPackageVersion(version):
+		return nil, nil, nil, errInvalidVersion
+	case !validDistPath(path):
+		return nil, nil, nil, errInvalidPath
+	}
+	return pkg, version, path, nil
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
+
+// validPackageName checks the syntax of a Perl package name: identifiers
+// separated by "::" (or the old "'").
+func validPackageName(name []byte) bool {
+	if len(name) == 0 || name[0] >= '0' && name[0] <= '9' {
+		return false
+	}
+	sep := true
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case isIdentByte(c):
+			sep = false
+			continue
+		case sep:
+			return false
+		case c == '\'':
+		case c == ':' && i+1 < len(name) && name[i+1] == ':':
+			i++
+		default:
+			return false
+		}
+		sep = true
+	}
+	return !sep
+}
+
+// validPackageVersion checks the syntax of a version: "undef", or a lax
+// decimal or dotted version ("1.02", "v1.2.3", "0.01_01").
+func validPackageVersion(v []byte) bool {
+	if string(v) == "undef" {
+		return true
+	}
+	if len(v) > 0 && v[0] == 'v' {
+		v = v[1:]
+	}
+	digits, dot := false, false
+	for i, c := range v {
+		switch {
+		case c >= '0' && c <= '9':
+			digits, dot = true, false
+		case c == '.' && !dot:
+			dot = true
+		case c == '_' && digits:
+			// Developer release
+			if i == len(v)-1 {
+				return false
+			}
+			for _, c := range v[i+1:] {
+				if c < '0' || c > '9' {
+					return false
+				}
+			}
+			return true
+		default:
+			return false
+		}
+	}
+	return digits
+}
+
+func isAuthorByte(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-'
+}
+
+// validDistPath checks the syntax of a path under authors/id, such as
+// "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz": the directory of the author, then
+// relative path segments other than "." and "..".
+func validDistPath(p []byte) bool {
+	// "A/AB/AB/f" at least
+	if len(p) < 9 || p[1] != '/' || p[4] != '/' || p[0] != p[2] || p[0] < 'A' || p[0] > 'Z' {
+		return false
+	}
+	id := p[5:]
+	i := bytes.IndexByte(id, '/')
+	if i < 2 || id[0] != p[2] || id[1] != p[3] {
+		return false
+	}
+	for _, c := range id[:i] {
+		if !isAuthorByte(c) {
+			return false
+		}
+	}
+	for rest := id[i:]; len(rest) > 0; {
+		seg := rest[1:]
+		if j := bytes.IndexByte(seg, '/'); j >= 0 {
+			seg = seg[:j]
+		}
+		rest = rest[1+len(seg):]
+		switch string(seg) {
+		case "", ".", "..":
+			return false
+		}
+		for _, c := range seg {
+			if c < ' ' || c == '\\' || c == 0x7f {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// packagesPosition is the position of a line in the uncompressed
+// 02packages.
+type packagesPosition struct {
+	line   int
+	offset int64
+}
+
+// scanRawLines is like bufio.ScanLines, but keeps the final '\r' to count
+// offsets.
+func scanRawLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// scanPackagesLines calls fn with the fields of each line of r, which
+// starts at pos. An invalid line stops scanning with a *ParseError, unless
+// lenient: invalid lines are then skipped and returned as ParseErrors at the
+// end.
+func scanPackagesLines(r io.Reader, pos packagesPosition, lenient bool, fn func(pkg, version, path []byte) error) error {
+	var errs ParseErrors
+	s := bufio.NewScanner(r)
+	s.Split(scanRawLines)
+	for s.Scan() {
+		raw := s.Bytes()
+		line := bytes.TrimSuffix(raw, []byte{'\r'})
+		pkg, version, path, err := splitPackagesLine(line)
+		if err != nil {
+			perr := &ParseError{Line: pos.line, Offset: pos.offset, Text: string(line), Err: err}
+			if !lenient {
+				return perr
+			}
+			errs = append(errs, perr)
+		} else if err = fn(pkg, version, path); err != nil {
+			return err
+		}
+		pos.line++
+		pos.offset += int64(len(raw)) + 1
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	if errs != nil {
+		return errs
+	}
+	return nil
+}
+
+// ScanPackagesIndex reads a 02packages.details.txt.gz file and calls fn for
+// each entry, in order.
+//
+// The fields given to fn are slices of an internal buffer that are only
+// valid during the call: no memory is allocated per entry. If fn returns an
+// error, scanning stops and that error is returned. An invalid line stops
+// scanning with a *ParseError.
+func ScanPackagesIndex(r io.Reader, fn func(pkg, version, path []byte) error) (header map[string][]string, err error) {
+	return scanPackagesIndex(r, false, fn)
+}
+
+// ScanPackagesIndexLenient is like ScanPackagesIndex, but skips the invalid
+// lines: the error is the ParseErrors of the invalid lines, unless another
+// error occurred.
+func ScanPackagesIndexLenient(r io.Reader, fn func(pkg, version, path []byte) error) (header map[string][]string, err error) {
+	return scanPackagesIndex(r, true, fn)
+}
+
+func scanPackagesIndex(r io.Reader, lenient bool, fn func(pkg, version, path []byte) error) (header map[string][]string, err error) {
+	header, br, pos, err := readPackagesIndexHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	return header, scanPackagesLines(br, pos, lenient, fn)
+}
+
+// PackagesIndexBlockSize is the size of the blocks of lines parsed in
+// parallel by ParsePackagesIndex.
+var PackagesIndexBlockSize = 256 << 10
+
+// ParsePackagesIndex reads a whole 02packages.details.txt.gz file.
+//
+// Decompression runs concurrently with the parsing of blocks of lines by
+// workers goroutines (GOMAXPROCS if workers <= 0). Entries are allocated by
+// block, and the strings of versions and paths are interned by each worker:
+// the entries of the packages of a distribution usually share the same Path
+// string.
+//
+// The first invalid line fails parsing with a *ParseError.
+func ParsePackagesIndex(r io.Reader, workers int) (header map[string][]string, entries []*PackagesIndexEntry, err error) {
+	header, entries, errs, err := parsePackagesIndex(r, workers, false)
+	if err == nil && errs != nil {
+		return header, nil, errs[0]
+	}
+	return header, entries, err
+}
+
+// ParsePackagesIndexLenient is like ParsePackagesIndex, but skips the
+// invalid lines: entries are the valid lines, and the error is the
+// ParseErrors of the invalid lines, unless another error occurred.
+func ParsePackagesIndexLenient(r io.Reader, workers int) (header map[string][]string, entries []*PackagesIndexEntry, err error) {
+	header, entries, errs, err := parsePackagesIndex(r, workers, true)
+	if err == nil && errs != nil {
+		err = errs
+	}
+	return header, entries, err
+}
+
+func parsePackagesIndex(r io.Reader, workers int, lenient bool) (header map[string][]string, entries []*PackagesIndexEntry, errs ParseErrors, err error) {
+	header, br, pos, err := readPackagesIndexHeader(r)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	blocks := make(chan *packagesBlock, workers)
+	results := make(chan *packagesBlock, workers)
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			in := make(interner)
+			for b := range blocks {
+				b.parse(in, lenient)
+				results <- b
+			}
+		}()
+	}
+
+	readErr := make(chan error, 1)
+	go func() {
+		readErr <- readPackagesBlocks(br, pos.offset, blocks, stop)
+		close(blocks)
+		wg.Wait()
+		close(results)
+	}()
+
+	// Reassemble the blocks in order
+	var parsed []*packagesBlock
+	stopped := false
+	for b := range results {
+		for len(parsed) <= b.seq {
+			parsed = append(parsed, nil)
+		}
+		parsed[b.seq] = b
+		if b.errs != nil && !lenient && !stopped {
+			close(stop)
+			stopped = true
+		}
+	}
+	if err = <-readErr; err != nil {
+		return header, nil, nil, err
+	}
+
+	count := 0
+	line := pos.line
+	for _, b := range parsed {
+		for _, e := range b.errs {
+			e.Line += line
+			errs = append(errs, e)
+		}
+		if errs != nil && !lenient {
+			return header, nil, errs, nil
+		}
+		line += b.lines
+		count += len(b.entries)
+	}
+
+	entries = make([]*PackagesIndexEntry, 0, count)
+	for _, b := range parsed {
+		entries = append(entries, b.entries...)
+	}
+	return header, entries, errs, nil
+}
+
+// packagesBlock is a block of complete lines of 02packages.
+type packagesBlock struct {
+	seq    int
+	offset int64
+	data   []byte
+	// lines is the number of lines of the block.
+	lines   int
+	entries []*PackagesIndexEntry
+	// errs are the invalid lines, with a Line relative to the block.
+	errs []*ParseError
+}
+
+// readPackagesBlocks splits r, which starts at offset, into blocks of
+// complete lines sent to blocks, until the end of r or stop is closed.
+func readPackagesBlocks(r io.Reader, offset int64, blocks chan<- *packagesBlock, stop <-chan struct{}) error {
+	var rest []byte
+	for seq := 0; ; seq++ {
+		size := PackagesIndexBlockSize
+		if len(rest) >= size {
+			// A line longer than a block
+			size = 2 * len(rest)
+		}
+		buf := make([]byte, size)
+		n := copy(buf, rest)
+		m, err := io.ReadFull(r, buf[n:])
+		buf = buf[:n+m]
+		eof := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !eof {
+			return err
+		}
+		rest = nil
+		if !eof {
+			i := bytes.LastIndexByte(buf, '\n')
+			if i < 0 {
+				rest = buf
+				seq--
+				continue
+			}
+			buf, rest = buf[:i+1], buf[i+1:]
+		}
+		if len(buf) > 0 {
+			select {
+			case blocks <- &packagesBlock{seq: seq, offset: offset, data: buf}:
+			case <-stop:
+				return nil
+			}
+			offset += int64(len(buf))
+		}
+		if eof {
+			return nil
+		}
+	}
+}
+
+// parse parses the lines of the block. Unless lenient, parsing stops at the
+// first invalid line.
+func (b *packagesBlock) parse(in interner, lenient bool) {
+	data := b.data
+	b.data = nil
+	n := bytes.Count(data, []byte{'\n'}) + 1
+	slab := make([]PackagesIndexEntry, 0, n)
+	// The names of packages are unique: they are gathered in a single
+	// string for the block
+	names := make([]byte, 0, len(data)/2)
+	ends := make([]int, 0, n)
+	offset := b.offset
+	for ; len(data) > 0; b.lines++ {
+		var line []byte
+		next := len(data)
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			line, next = data[:i], i+1
+		} else {
+			line = data
+		}
+		data = data[next:]
+		line = bytes.TrimSuffix(line, []byte{'\r'})
+		pkg, version, path, err := splitPackagesLine(line)
+		if err != nil {
+			b.errs = append(b.errs, &ParseError{Line: b.lines, Offset: offset, Text: string(line), Err: err})
+			if !lenient {
+				return
+			}
+		} else {
+			names = append(names, pkg...)
+			ends = append(ends, len(names))
+			slab = append(slab, PackagesIndexEntry{
+				Version: in.intern(version),
+				Path:    in.intern(path),
+			})
+		}
+		offset += int64(next)
+	}
+	all := string(names)
+	b.entries = make([]*PackagesIndexEntry, len(slab))
+	start := 0
+	for i := range slab {
+		slab[i].Package = all[start:ends[i]]
+		start = ends[i]
+		b.entries[i] = &slab[i]
+	}
+}
+
+// interner deduplicates strings.
+type interner map[string]string
+
+func (in interner) intern(b []byte) string {
+	if s, ok := in[string(b)]; ok {
+		return s
+	}
+	s := string(b)
+	in[s] = s
+	return s
+}
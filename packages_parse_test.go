@@ -0,0 +1,328 @@
+package CPAN
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func readTestPackagesIndex(tb testing.TB) []byte {
+	buf, err := ioutil.ReadFile("testdata/02packages.details.txt.gz")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return buf
+}
+
+func TestParsePackagesIndex(t *testing.T) {
+	data := readTestPackagesIndex(t)
+
+	header, ch, done := ReadPackagesIndex(bytes.NewReader(data))
+	var expected []*PackagesIndexEntry
+	for e := range ch {
+		expected = append(expected, e)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if len(expected) != 8050 {
+		t.Fatalf("got %d entries", len(expected))
+	}
+
+	defer func(size int) { PackagesIndexBlockSize = size }(PackagesIndexBlockSize)
+	for _, size := range []int{10, 4096, 256 << 10} {
+		PackagesIndexBlockSize = size
+		for _, workers := range []int{1, 4} {
+			h, entries, err := ParsePackagesIndex(bytes.NewReader(data), workers)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(h, header) {
+				t.Errorf("block size %d, %d workers: got header %v", size, workers, h)
+			}
+			if !reflect.DeepEqual(entries, expected) {
+				t.Errorf("block size %d, %d workers: entries mismatch", size, workers)
+			}
+		}
+	}
+
+	var n int
+	var last string
+	h, err := ScanPackagesIndex(bytes.NewReader(data), func(pkg, version, path []byte) error {
+		if e := expected[n]; string(pkg) != e.Package || string(version) != e.Version || string(path) != e.Path {
+			t.Fatalf("%d: got %s %s %s", n, pkg, version, path)
+		}
+		n++
+		last = string(pkg)
+		return nil
+	})
+	if err != nil || n != len(expected) || !reflect.DeepEqual(h, header) {
+		t.Errorf("scan: got %d entries, error %v", n, err)
+	}
+	if last != expected[len(expected)-1].Package {
+		t.Errorf("scan: last %q", last)
+	}
+
+	errStop := errors.New("stop")
+	n = 0
+	_, err = ScanPackagesIndex(bytes.NewReader(data), func(pkg, version, path []byte) error {
+		if n++; n == 10 {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop || n != 10 {
+		t.Errorf("scan stop: got %d entries, error %v", n, err)
+	}
+}
+
+func TestPackagesIndexRoundTrip(t *testing.T) {
+	header, entries, err := ParsePackagesIndex(bytes.NewReader(readTestPackagesIndex(t)), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err = WritePackagesIndex(&buf, header, entries); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(gunzip(t, buf.Bytes()), gunzip(t, readTestPackagesIndex(t))) {
+		t.Error("content mismatch")
+	}
+	h, got, err := ParsePackagesIndex(&buf, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(h, header) || !reflect.DeepEqual(got, entries) {
+		t.Error("round trip mismatch")
+	}
+}
+
+func gunzip(t *testing.T, data []byte) []byte {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+func TestSplitPackagesLine(t *testing.T) {
+	for _, test := range []struct {
+		line string
+		err  error
+	}{
+		{"Foo::Bar 1.02  D/DO/DOLMEN/Foo-Bar-1.02.tar.gz", nil},
+		{"Foo::Bar                          undef  D/DO/DOLMEN/Foo-Bar-1.02.tar.gz", nil},
+		{"_Foo v1.2.3 D/DO/DOLMEN/sub/Foo-v1.2.3.tar.gz", nil},
+		{"Acme::Don't 0.01_01 D/DO/DO/Acme-Don-t-0.01_01.tar.gz", nil},
+		{"Foo .5 D/DO/DOLMEN/Foo-.5.tar.gz", nil},
+		{"Foo::Bar", errMissingSeparator},
+		{"   ", errInvalidPackage},
+		{" 1.0 D/DO/DOLMEN/Foo-1.0.tar.gz", errInvalidPackage},
+		{"1Foo 1.0 D/DO/DOLMEN/Foo-1.0.tar.gz", errInvalidPackage},
+		{"Foo:: 1.0 D/DO/DOLMEN/Foo-1.0.tar.gz", errInvalidPackage},
+		{"Foo:Bar 1.0 D/DO/DOLMEN/Foo-1.0.tar.gz", errInvalidPackage},
+		{"Foo::::Bar 1.0 D/DO/DOLMEN/Foo-1.0.tar.gz", errInvalidPackage},
+		{"Foo\tBar 1.0 D/DO/DOLMEN/Foo-1.0.tar.gz", errInvalidPackage},
+		{"Foo 1.0 1.1 D/DO/DOLMEN/Foo-1.0.tar.gz", errTooManyFields},
+		{"Foo D/DO/DOLMEN/Foo-1.0.tar.gz", errInvalidVersion},
+		{"Foo  D/DO/DOLMEN/Foo-1.0.tar.gz", errInvalidVersion},
+		{"Foo 1..0 D/DO/DOLMEN/Foo-1.0.tar.gz", errInvalidVersion},
+		{"Foo 1.0_ D/DO/DOLMEN/Foo-1.0.tar.gz", errInvalidVersion},
+		{"Foo 1.0a D/DO/DOLMEN/Foo-1.0.tar.gz", errInvalidVersion},
+		{"Foo 1.0 ", errInvalidPath},
+		{"Foo 1.0 Foo-1.0.tar.gz", errInvalidPath},
+		{"Foo 1.0 D/DO/DOLMEN/", errInvalidPath},
+		{"Foo 1.0 D/DO/DOLMEN/../X/Foo-1.0.tar.gz", errInvalidPath},
+		{"Foo 1.0 D/DO/DOLMEN//Foo-1.0.tar.gz", errInvalidPath},
+		{"Foo 1.0 E/DO/DOLMEN/Foo-1.0.tar.gz", errInvalidPath},
+		{"Foo 1.0 D/DA/DOLMEN/Foo-1.0.tar.gz", errInvalidPath},
+		{"Foo 1.0 D/DO/dolmen/Foo-1.0.tar.gz", errInvalidPath},
+		{"Foo 1.0 d/do/dolmen/Foo-1.0.tar.gz", errInvalidPath},
+		{"Foo 1.0 ../../etc/passwd", errInvalidPath},
+	} {
+		_, _, _, err := splitPackagesLine([]byte(test.line))
+		if err != test.err {
+			t.Errorf("%q: got %v, expected %v", test.line, err, test.err)
+		}
+	}
+}
+
+func TestReadPackagesIndexStop(t *testing.T) {
+	data := readTestPackagesIndex(t)
+	_, ch, done := ReadPackagesIndex(bytes.NewReader(data))
+	var all []*PackagesIndexEntry
+	for e := range ch {
+		all = append(all, e)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	defer func(size int) { ReadPackagesIndexBufferSize = size }(ReadPackagesIndexBufferSize)
+	ReadPackagesIndexBufferSize = 1
+
+	// Not stopped: no entry is lost
+	_, ch, done = ReadPackagesIndexStop(bytes.NewReader(data), make(chan struct{}))
+	var got []*PackagesIndexEntry
+	for e := range ch {
+		got = append(got, e)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, all) {
+		t.Errorf("got %d entries, want %d", len(got), len(all))
+	}
+
+	stop := make(chan struct{})
+	_, ch, done = ReadPackagesIndexStop(bytes.NewReader(data), stop)
+	if e := <-ch; e == nil || *e != *all[0] {
+		t.Fatalf("got %v", e)
+	}
+	close(stop)
+	n := 1
+	for range ch {
+		n++
+	}
+	if err := <-done; err != ErrReadAborted {
+		t.Errorf("got %v", err)
+	}
+	if n == len(all) {
+		t.Error("not stopped")
+	}
+}
+
+func TestParsePackagesIndexError(t *testing.T) {
+	lines := make([]string, 1000)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("Foo%d 1.0  F/FO/FOO/Foo-1.0.tar.gz", i)
+	}
+	lines[500] = "Foo::Bar"
+	lines[900] = "   "
+	// The header has 2 lines
+	content := "File: 02packages.details.txt\n\n" + strings.Join(lines, "\n") + "\n"
+	data := gzipString(t, content).Bytes()
+	check := func(name string, err error, line int, text string, e error) {
+		t.Helper()
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Fatalf("%s: got %v", name, err)
+		}
+		if perr.Line != line || perr.Text != text || perr.Err != e {
+			t.Errorf("%s: got %v", name, err)
+		}
+		if !strings.HasPrefix(content[perr.Offset:], text+"\n") {
+			t.Errorf("%s: offset %d", name, perr.Offset)
+		}
+	}
+
+	_, ch, done := ReadPackagesIndex(bytes.NewReader(data))
+	n := 0
+	for range ch {
+		n++
+	}
+	check("read", <-done, 503, "Foo::Bar", errMissingSeparator)
+	if n != 500 {
+		t.Errorf("read: got %d entries", n)
+	}
+
+	_, err := ScanPackagesIndex(bytes.NewReader(data), func(pkg, version, path []byte) error { return nil })
+	check("scan", err, 503, "Foo::Bar", errMissingSeparator)
+
+	checkLenient := func(name string, err error) {
+		t.Helper()
+		errs, ok := err.(ParseErrors)
+		if !ok || len(errs) != 2 {
+			t.Fatalf("%s: got %v", name, err)
+		}
+		check(name, errs[0], 503, "Foo::Bar", errMissingSeparator)
+		check(name, errs[1], 903, "   ", errInvalidPackage)
+	}
+
+	_, ch, done = ReadPackagesIndexLenient(bytes.NewReader(data))
+	n = 0
+	for e := range ch {
+		if n == 500 && e.Package != "Foo501" {
+			t.Errorf("read lenient: got %s", e.Package)
+		}
+		n++
+	}
+	checkLenient("read lenient", <-done)
+	if n != 998 {
+		t.Errorf("read lenient: got %d entries", n)
+	}
+
+	n = 0
+	_, err = ScanPackagesIndexLenient(bytes.NewReader(data), func(pkg, version, path []byte) error {
+		n++
+		return nil
+	})
+	checkLenient("scan lenient", err)
+	if n != 998 {
+		t.Errorf("scan lenient: got %d entries", n)
+	}
+
+	defer func(size int) { PackagesIndexBlockSize = size }(PackagesIndexBlockSize)
+	for _, size := range []int{100, 4096} {
+		PackagesIndexBlockSize = size
+		_, entries, err := ParsePackagesIndex(bytes.NewReader(data), 4)
+		check("parse", err, 503, "Foo::Bar", errMissingSeparator)
+		if entries != nil {
+			t.Errorf("parse: got %d entries", len(entries))
+		}
+
+		_, entries, err = ParsePackagesIndexLenient(bytes.NewReader(data), 4)
+		checkLenient("lenient", err)
+		if len(entries) != 998 || entries[500].Package != "Foo501" {
+			t.Errorf("lenient: got %d entries", len(entries))
+		}
+	}
+}
+
+func BenchmarkReadPackagesIndex(b *testing.B) {
+	data := readTestPackagesIndex(b)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, ch, done := ReadPackagesIndex(bytes.NewReader(data))
+		for range ch {
+		}
+		if err := <-done; err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkScanPackagesIndex(b *testing.B) {
+	data := readTestPackagesIndex(b)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := ScanPackagesIndex(bytes.NewReader(data), func(pkg, version, path []byte) error {
+			return nil
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParsePackagesIndex(b *testing.B) {
+	data := readTestPackagesIndex(b)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := ParsePackagesIndex(bytes.NewReader(data), 0); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package CPAN
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strings"
+)
+
+// Permissions of 06perms.txt.
+const (
+	PermModuleList = "m" // owner, registered in the module list
+	PermFirstCome  = "f" // first-come owner
+	PermCoMaint    = "c" // co-maintainer
+	PermAdmin      = "a" // admin
+)
+
+// Perm is the permission of an author to upload a package, from
+// 06perms.txt.gz.
+type Perm struct {
+	Package string `json:"package"`
+	Author  string `json:"author"`
+	Perm    string `json:"perm"`
+}
+
+// ReadPerms reads a 06perms.txt.gz file.
+func ReadPerms(r io.Reader) (header map[string][]string, perms []*Perm, err error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	headerR := textproto.NewReader(bufio.NewReader(gz))
+	header, err = headerR.ReadMIMEHeader()
+	if err != nil {
+		return nil, nil, err
+	}
+	s := bufio.NewScanner(headerR.R)
+	lineNum := 0
+	for s.Scan() {
+		lineNum++
+		line := s.Text()
+		if line == "" {
+			continue
+		}
+		f := strings.Split(line, ",")
+		if len(f) != 3 || f[0] == "" || f[1] == "" || len(f[2]) != 1 {
+			return nil, nil, fmt.Errorf("06perms: line %d after header: invalid line", lineNum)
+		}
+		perms = append(perms, &Perm{Package: f[0], Author: f[1], Perm: f[2]})
+	}
+	if err = s.Err(); err != nil {
+		return nil, nil, err
+	}
+	return header, perms, nil
+}
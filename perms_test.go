@@ -0,0 +1,38 @@
+package CPAN
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReadPerms(t *testing.T) {
+	header, perms, err := ReadPerms(gzipString(t, `File:        06perms.txt
+Description: CSV file of upload permission to the CPAN per namespace
+    best-permission is one of "m" for "modulelist", "f" for
+    "first-come", "c" for "co-maint"
+Columns:     package,userid,best-permission
+Line-Count:  3
+
+Foo::Bar,DOLMEN,f
+Foo::Bar,OTHER,c
+Foo::Baz,DOLMEN,m
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header["Line-Count"][0] != "3" {
+		t.Errorf("header: %v", header)
+	}
+	expected := []*Perm{
+		{"Foo::Bar", "DOLMEN", PermFirstCome},
+		{"Foo::Bar", "OTHER", PermCoMaint},
+		{"Foo::Baz", "DOLMEN", PermModuleList},
+	}
+	if !reflect.DeepEqual(perms, expected) {
+		t.Errorf("got %+v", perms)
+	}
+
+	if _, _, err = ReadPerms(gzipString(t, "File: 06perms.txt\n\nFoo::Bar,DOLMEN\n")); err == nil {
+		t.Error("error expected")
+	}
+}
@@ -0,0 +1,242 @@
+package CPAN
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Epoch is a timestamp of a RECENT feed: seconds since the Unix epoch with
+// sub-second precision. The decimal text of the feed is kept as is because
+// float64 does not have enough precision to tell apart close events.
+type Epoch string
+
+func (e *Epoch) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		*e = Epoch(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return err
+	}
+	*e = Epoch(n)
+	return nil
+}
+
+func (e Epoch) rat() *big.Rat {
+	r, ok := new(big.Rat).SetString(string(e))
+	if !ok {
+		return new(big.Rat)
+	}
+	return r
+}
+
+// Cmp compares e and f numerically and returns -1, 0 or +1.
+// The empty Epoch is lower than any other.
+func (e Epoch) Cmp(f Epoch) int {
+	switch {
+	case e == f:
+		return 0
+	case e == "":
+		return -1
+	case f == "":
+		return 1
+	}
+	return e.rat().Cmp(f.rat())
+}
+
+// Float64 returns the nearest float64 value of e.
+func (e Epoch) Float64() float64 {
+	f, _ := strconv.ParseFloat(string(e), 64)
+	return f
+}
+
+// Time converts e to a time.Time (with microsecond precision).
+func (e Epoch) Time() time.Time {
+	s := string(e)
+	i := strings.IndexByte(s, '.')
+	if i == -1 {
+		sec, _ := strconv.ParseInt(s, 10, 64)
+		return time.Unix(sec, 0)
+	}
+	sec, _ := strconv.ParseInt(s[:i], 10, 64)
+	frac := (s[i+1:] + "000000000")[:9]
+	nsec, _ := strconv.ParseInt(frac, 10, 64)
+	return time.Unix(sec, nsec/1000*1000)
+}
+
+// Event types of a RECENT feed.
+const (
+	RecentNew    = "new"
+	RecentDelete = "delete"
+)
+
+// RecentEvent is a change recorded in a RECENT feed.
+// Path is relative to the directory containing the RECENT file.
+type RecentEvent struct {
+	Epoch Epoch  `json:"epoch" yaml:"epoch"`
+	Path  string `json:"path" yaml:"path"`
+	Type  string `json:"type" yaml:"type"`
+}
+
+// RecentMeta is the "meta" section of a RECENT file.
+type RecentMeta struct {
+	Aggregator       []string `json:"aggregator" yaml:"aggregator"`
+	Canonize         string   `json:"canonize" yaml:"canonize"`
+	Comment          string   `json:"comment" yaml:"comment"`
+	Dirtymark        Epoch    `json:"dirtymark" yaml:"dirtymark"`
+	FilenameRoot     string   `json:"filenameroot" yaml:"filenameroot"`
+	Interval         string   `json:"interval" yaml:"interval"`
+	SerializerSuffix string   `json:"serializer_suffix" yaml:"serializer_suffix"`
+	Merged           struct {
+		Epoch        Epoch  `json:"epoch" yaml:"epoch"`
+		IntoInterval string `json:"into_interval" yaml:"into_interval"`
+	} `json:"merged" yaml:"merged"`
+	Minmax struct {
+		Max Epoch `json:"max" yaml:"max"`
+		Min Epoch `json:"min" yaml:"min"`
+	} `json:"minmax" yaml:"minmax"`
+}
+
+// RecentFile is the content of a RECENT-*.json or RECENT-*.yaml file
+// published by File::Rsync::Mirror::Recent.
+// Events are sorted by decreasing epoch.
+type RecentFile struct {
+	Meta   RecentMeta    `json:"meta" yaml:"meta"`
+	Recent []RecentEvent `json:"recent" yaml:"recent"`
+}
+
+// ReadRecent loads a RECENT file. Both the JSON and the YAML serializations
+// are supported.
+func ReadRecent(r io.Reader) (*RecentFile, error) {
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var f RecentFile
+	if b := bytes.TrimLeft(content, " \t\r\n"); len(b) > 0 && b[0] == '{' {
+		err = json.Unmarshal(b, &f)
+	} else {
+		err = yaml.Unmarshal(content, &f)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if f.Meta.Interval == "" {
+		return nil, ErrNoData
+	}
+	return &f, nil
+}
+
+// RecentOpener opens the RECENT file with the given name (for example
+// "RECENT-6h.json") from the same directory as the principal RECENT file.
+type RecentOpener func(name string) (io.ReadCloser, error)
+
+func readRecentFile(open RecentOpener, name string) (*RecentFile, error) {
+	f, err := open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	rf, err := ReadRecent(bufio.NewReader(f))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", name, err)
+	}
+	return rf, nil
+}
+
+// RecentChanges walks the chain of RECENT files starting from principal
+// (usually "RECENT-1h.json") through the intervals listed in its aggregator
+// until one covers since, and returns all the changes newer than since.
+// Only the latest event is kept for each path. The result is sorted by
+// decreasing epoch.
+//
+// If since is empty, the whole chain is read, up to the "Z" file.
+//
+// The dirtymark of the principal file is also returned: if it differs from
+// the one recorded at the previous run, the feed has been rebuilt and the
+// caller must resynchronize completely.
+func RecentChanges(open RecentOpener, principal string, since Epoch) (events []RecentEvent, dirtymark Epoch, err error) {
+	first, err := readRecentFile(open, principal)
+	if err != nil {
+		return nil, "", err
+	}
+	dirtymark = first.Meta.Dirtymark
+
+	root := first.Meta.FilenameRoot
+	if root == "" {
+		root = "RECENT"
+	}
+	suffix := first.Meta.SerializerSuffix
+	if suffix == "" {
+		if i := strings.LastIndexByte(principal, '.'); i >= 0 {
+			suffix = principal[i:]
+		}
+	}
+
+	latest := make(map[string]RecentEvent)
+	merge := func(rf *RecentFile) {
+		for _, ev := range rf.Recent {
+			if since != "" && ev.Epoch.Cmp(since) <= 0 {
+				// Events are sorted by decreasing epoch
+				break
+			}
+			if prev, seen := latest[ev.Path]; !seen || ev.Epoch.Cmp(prev.Epoch) > 0 {
+				latest[ev.Path] = ev
+			}
+		}
+	}
+
+	rf := first
+	intervals := first.Meta.Aggregator
+	for i, interval := range intervals {
+		if interval == first.Meta.Interval {
+			intervals = intervals[i+1:]
+			break
+		}
+	}
+	for {
+		merge(rf)
+		if since != "" && rf.Meta.Minmax.Min != "" && rf.Meta.Minmax.Min.Cmp(since) <= 0 {
+			break
+		}
+		if len(intervals) == 0 {
+			if rf.Meta.Interval != "Z" {
+				return nil, dirtymark, fmt.Errorf("RECENT chain ended before epoch %s", since)
+			}
+			break
+		}
+		rf, err = readRecentFile(open, root+"-"+intervals[0]+suffix)
+		if err != nil {
+			return nil, dirtymark, err
+		}
+		intervals = intervals[1:]
+	}
+
+	events = make([]RecentEvent, 0, len(latest))
+	for _, ev := range latest {
+		events = append(events, ev)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if c := events[i].Epoch.Cmp(events[j].Epoch); c != 0 {
+			return c > 0
+		}
+		return events[i].Path < events[j].Path
+	})
+	return events, dirtymark, nil
+}
@@ -0,0 +1,100 @@
+package CPAN
+
+import (
+	"io"
+	"os"
+	"testing"
+)
+
+func openTestdata(name string) (io.ReadCloser, error) {
+	return os.Open("testdata/" + name)
+}
+
+func TestEpochCmp(t *testing.T) {
+	for _, test := range []struct {
+		a, b Epoch
+		cmp  int
+	}{
+		{"1479802961.936207", "1479802961.936206", 1},
+		{"1479802961.9362060", "1479802961.936206", 0},
+		{"1479720000", "1479720000.0", 0},
+		{"1320000000.1", "1479720000", -1},
+		{"", "1", -1},
+	} {
+		if got := test.a.Cmp(test.b); got != test.cmp {
+			t.Errorf("%q.Cmp(%q): got %d, expected %d", test.a, test.b, got, test.cmp)
+		}
+	}
+
+	if got := Epoch("1479802961.936207").Time().Nanosecond(); got != 936207000 {
+		t.Errorf("Time: got %d ns", got)
+	}
+}
+
+func TestReadRecent(t *testing.T) {
+	for _, name := range []string{"RECENT-1h.json", "RECENT-1h.yaml"} {
+		f, err := openTestdata(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rf, err := ReadRecent(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if rf.Meta.Interval != "1h" || len(rf.Meta.Aggregator) != 3 {
+			t.Errorf("%s: unexpected meta: %+v", name, rf.Meta)
+		}
+		if rf.Meta.Minmax.Max != "1479802961.936207" {
+			t.Errorf("%s: max: got %q", name, rf.Meta.Minmax.Max)
+		}
+		if rf.Recent[0].Path != "id/D/DO/DOLMEN/CHECKSUMS" || rf.Recent[0].Type != RecentNew {
+			t.Errorf("%s: unexpected first event: %+v", name, rf.Recent[0])
+		}
+	}
+}
+
+func TestRecentChanges(t *testing.T) {
+	var opened []string
+	open := func(name string) (io.ReadCloser, error) {
+		opened = append(opened, name)
+		return openTestdata(name)
+	}
+
+	events, dirtymark, err := RecentChanges(open, "RECENT-1h.json", "1479789000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dirtymark != "1325155443.71674" {
+		t.Errorf("dirtymark: got %q", dirtymark)
+	}
+	if len(opened) != 2 {
+		t.Errorf("files read: %q", opened)
+	}
+	expected := []RecentEvent{
+		{"1479802961.936207", "id/D/DO/DOLMEN/CHECKSUMS", RecentNew},
+		{"1479802961.936206", "id/D/DO/DOLMEN/Git-Sub-0.163320.tar.gz", RecentNew},
+		{"1479800401.113506", "id/D/DO/DOLMEN/Git-Sub-0.163130.tar.gz", RecentDelete},
+		{"1479790000.25", "id/D/DO/DOLMEN/Pod-Spell-1.20.tar.gz", RecentNew},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("got %d events, expected %d: %+v", len(events), len(expected), events)
+	}
+	for i := range expected {
+		if events[i] != expected[i] {
+			t.Errorf("event %d: got %+v, expected %+v", i, events[i], expected[i])
+		}
+	}
+
+	opened = nil
+	events, _, err = RecentChanges(open, "RECENT-1h.json", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(opened) != 4 {
+		t.Errorf("files read: %q", opened)
+	}
+	if len(events) != 6 {
+		t.Errorf("got %d events: %+v", len(events), events)
+	}
+}
@@ -0,0 +1,412 @@
+// Package resolver computes the distributions to install to satisfy a set of
+// module requirements, using the CPAN packages index and the META prereqs of
+// each distribution, like cpanm does.
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+// DefaultPhases are the phases of the prereqs of distributions that must be
+// installed before them.
+var DefaultPhases = []string{"configure", "build", "test", "runtime"}
+
+// Index maps modules to the distribution that provides them.
+type Index interface {
+	Lookup(module string) *CPAN.PackagesIndexEntry
+}
+
+// IndexMap is an Index in memory.
+type IndexMap map[string]*CPAN.PackagesIndexEntry
+
+// NewIndexMap returns an IndexMap of entries, read with
+// CPAN.ReadPackagesIndex for example.
+func NewIndexMap(entries []*CPAN.PackagesIndexEntry) IndexMap {
+	m := make(IndexMap, len(entries))
+	for _, e := range entries {
+		m[e.Package] = e
+	}
+	return m
+}
+
+// Lookup implements Index.
+func (m IndexMap) Lookup(module string) *CPAN.PackagesIndexEntry {
+	return m[module]
+}
+
+// MetaFunc returns the META of a distribution, given its path relative to
+// authors/id. It may return nil if the distribution has no META.
+type MetaFunc func(ctx context.Context, distPath string) (*CPAN.Meta, error)
+
+// ClientMeta returns a MetaFunc that downloads distributions with c.
+func ClientMeta(c *CPAN.Client) MetaFunc {
+	return func(ctx context.Context, distPath string) (*CPAN.Meta, error) {
+		r, err := c.Dist(ctx, distPath)
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		d, err := CPAN.ReadDist(r, distPath)
+		if err != nil {
+			return nil, err
+		}
+		// Drain to let the cache complete
+		io.Copy(ioutil.Discard, r)
+		return d.Meta, nil
+	}
+}
+
+// Resolver resolves requirements.
+type Resolver struct {
+	Index Index
+	Meta  MetaFunc
+	// Core, if not nil, reports whether the module is provided by the target
+	// perl with a version in r. Core modules are not installed.
+	Core func(module string, r CPAN.VersionRange) bool
+	// Perl, if not empty, is the version of the target perl, checked
+	// against the requirements on "perl". Otherwise they are ignored.
+	Perl string
+	// Phases are the phases of the prereqs of distributions that are
+	// followed. DefaultPhases if nil.
+	Phases []string
+	// Recommends also follows the recommends relationship.
+	Recommends bool
+}
+
+// Requirement is a version range required by a distribution.
+type Requirement struct {
+	// By is the path of the distribution that requires the module, or the
+	// empty string for the root requirements.
+	By    string
+	Range string
+}
+
+func (r Requirement) String() string {
+	by := r.By
+	if by == "" {
+		by = "root"
+	}
+	return fmt.Sprintf("%s (%s)", by, r.Range)
+}
+
+// Step is the installation of a distribution.
+type Step struct {
+	// Dist is the path of the distribution relative to authors/id.
+	Dist string
+	// Modules are the modules required from this distribution, with their
+	// version in the index.
+	Modules map[string]string
+	// Requires are the distributions of the plan that must be installed
+	// before.
+	Requires []string
+	Meta     *CPAN.Meta
+}
+
+// Plan is a list of installation steps in topological order: each
+// distribution comes after the distributions it requires.
+type Plan []*Step
+
+// UnsatisfiableError explains why a module requirement can not be
+// satisfied.
+type UnsatisfiableError struct {
+	Module string
+	// Available is the version in the index, or the version of perl.
+	// It is empty if the module is not indexed.
+	Available string
+	// Dist is the distribution that provides the module in the index.
+	Dist string
+	// RequiredBy are the requirements on the module.
+	RequiredBy []Requirement
+	// Conflict, if not nil, is the conflicts declaration that excludes the
+	// available version.
+	Conflict *Requirement
+}
+
+func (e *UnsatisfiableError) Error() string {
+	reqs := make([]string, len(e.RequiredBy))
+	for i, r := range e.RequiredBy {
+		reqs[i] = r.String()
+	}
+	var b strings.Builder
+	b.WriteString(e.Module)
+	switch {
+	case e.Available == "":
+		b.WriteString(": not found in the index")
+	case e.Conflict != nil:
+		fmt.Fprintf(&b, ": version %s from %s conflicts with %s", e.Available, e.Dist, e.Conflict)
+	case e.Module == "perl":
+		fmt.Fprintf(&b, ": version %s of perl does not satisfy the requirements", e.Available)
+	default:
+		fmt.Fprintf(&b, ": version %s from %s does not satisfy the requirements", e.Available, e.Dist)
+	}
+	b.WriteString("; required by ")
+	b.WriteString(strings.Join(reqs, ", "))
+	return b.String()
+}
+
+// UnsatisfiableErrors is returned by Resolve when some requirements can not
+// be satisfied.
+type UnsatisfiableErrors []*UnsatisfiableError
+
+func (e UnsatisfiableErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// CycleError is returned by Resolve when distributions require each other.
+type CycleError struct {
+	// Dists is the cycle: the last requires the first.
+	Dists []string
+}
+
+func (e *CycleError) Error() string {
+	return "dependency cycle: " + strings.Join(append(e.Dists, e.Dists[0]), " -> ")
+}
+
+type moduleState struct {
+	reqs     []Requirement
+	combined CPAN.VersionRange
+	core     bool
+	entry    *CPAN.PackagesIndexEntry
+}
+
+type resolution struct {
+	*Resolver
+	modules   map[string]*moduleState
+	dists     map[string]*Step
+	queue     []string
+	conflicts map[string][]Requirement
+	errs      UnsatisfiableErrors
+}
+
+// Resolve returns the plan to install the distributions needed by the root
+// requirements, such as the prereqs of a cpanfile:
+//
+//	plan, err := r.Resolve(ctx, cpanfile.Prereqs.Merge("requires", "runtime"))
+//
+// Invalid version ranges are returned as errors. The index has a single
+// version of each module: if it is not in the required range an
+// UnsatisfiableErrors is returned that lists every problem.
+func (r *Resolver) Resolve(ctx context.Context, reqs CPAN.Requirements) (Plan, error) {
+	res := &resolution{
+		Resolver:  r,
+		modules:   make(map[string]*moduleState),
+		dists:     make(map[string]*Step),
+		conflicts: make(map[string][]Requirement),
+	}
+	phases := r.Phases
+	if phases == nil {
+		phases = DefaultPhases
+	}
+
+	var roots []string
+	if err := res.require(reqs, "", &roots); err != nil {
+		return nil, err
+	}
+	for len(res.queue) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		dist := res.queue[0]
+		res.queue = res.queue[1:]
+		step := res.dists[dist]
+		meta, err := r.Meta(ctx, dist)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", dist, err)
+		}
+		step.Meta = meta
+		if meta == nil {
+			continue
+		}
+		deps := meta.Prereqs.Merge("requires", phases...)
+		if r.Recommends {
+			for module, rng := range meta.Prereqs.Merge("recommends", phases...) {
+				deps.Add(module, rng)
+			}
+		}
+		if err = res.require(deps, dist, &step.Requires); err != nil {
+			return nil, err
+		}
+		for module, rng := range meta.Prereqs.Merge("conflicts", phases...) {
+			res.conflicts[module] = append(res.conflicts[module], Requirement{By: dist, Range: rng})
+		}
+	}
+
+	res.check()
+	if len(res.errs) > 0 {
+		return nil, res.errs
+	}
+	return res.plan(roots)
+}
+
+// require adds requirements from the distribution by (empty for the root),
+// and appends to deps the distributions that provide them.
+func (res *resolution) require(reqs CPAN.Requirements, by string, deps *[]string) error {
+	modules := make([]string, 0, len(reqs))
+	for module := range reqs {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	for _, module := range modules {
+		rng, err := CPAN.ParseVersionRange(reqs[module])
+		if err != nil {
+			if by != "" {
+				return fmt.Errorf("%s: %s: %s", by, module, err)
+			}
+			return fmt.Errorf("%s: %s", module, err)
+		}
+		ms := res.modules[module]
+		if ms == nil {
+			ms = &moduleState{}
+			res.modules[module] = ms
+		}
+		ms.reqs = append(ms.reqs, Requirement{By: by, Range: reqs[module]})
+		ms.combined = append(ms.combined, rng...)
+
+		if module == "perl" || ms.entry != nil {
+			if ms.entry != nil && ms.entry.Path != by {
+				*deps = appendUnique(*deps, ms.entry.Path)
+			}
+			continue
+		}
+		// Ranges only get stricter: a module that is not core stays so
+		if res.Core != nil && res.Core(module, ms.combined) {
+			ms.core = true
+			continue
+		}
+		ms.core = false
+		ms.entry = res.Index.Lookup(module)
+		if ms.entry == nil {
+			continue
+		}
+		dist := ms.entry.Path
+		step := res.dists[dist]
+		if step == nil {
+			step = &Step{Dist: dist, Modules: make(map[string]string)}
+			res.dists[dist] = step
+			res.queue = append(res.queue, dist)
+		}
+		if dist != by {
+			step.Modules[module] = ms.entry.Version
+			*deps = appendUnique(*deps, dist)
+		}
+	}
+	return nil
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, e := range list {
+		if e == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
+
+// check verifies that the versions available satisfy the combined
+// requirements.
+func (res *resolution) check() {
+	modules := make([]string, 0, len(res.modules))
+	for module := range res.modules {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	for _, module := range modules {
+		ms := res.modules[module]
+		switch {
+		case module == "perl":
+			if res.Perl != "" && !ms.combined.AcceptsString(res.Perl) {
+				res.errs = append(res.errs, &UnsatisfiableError{
+					Module:     module,
+					Available:  res.Perl,
+					RequiredBy: ms.reqs,
+				})
+			}
+			continue
+		case ms.core:
+			continue
+		case ms.entry == nil:
+			res.errs = append(res.errs, &UnsatisfiableError{Module: module, RequiredBy: ms.reqs})
+			continue
+		}
+
+		err := &UnsatisfiableError{
+			Module:     module,
+			Available:  ms.entry.Version,
+			Dist:       ms.entry.Path,
+			RequiredBy: ms.reqs,
+		}
+		if !ms.combined.AcceptsString(ms.entry.Version) {
+			res.errs = append(res.errs, err)
+			continue
+		}
+		for _, c := range res.conflicts[module] {
+			rng, e := CPAN.ParseVersionRange(c.Range)
+			if e == nil && len(rng) > 0 && rng.AcceptsString(ms.entry.Version) {
+				c := c
+				err.Conflict = &c
+				res.errs = append(res.errs, err)
+				break
+			}
+		}
+	}
+}
+
+// plan sorts the distributions topologically, starting from roots.
+func (res *resolution) plan(roots []string) (Plan, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(res.dists))
+	var (
+		plan  Plan
+		stack []string
+		visit func(dist string) error
+	)
+	visit = func(dist string) error {
+		switch state[dist] {
+		case visited:
+			return nil
+		case visiting:
+			i := len(stack) - 1
+			for stack[i] != dist {
+				i--
+			}
+			return &CycleError{Dists: append([]string(nil), stack[i:]...)}
+		}
+		state[dist] = visiting
+		stack = append(stack, dist)
+		step := res.dists[dist]
+		sort.Strings(step.Requires)
+		for _, dep := range step.Requires {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[dist] = visited
+		plan = append(plan, step)
+		return nil
+	}
+	sort.Strings(roots)
+	for _, dist := range roots {
+		if err := visit(dist); err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
@@ -0,0 +1,151 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+type testCPAN struct {
+	index IndexMap
+	metas map[string]*CPAN.Meta
+}
+
+// dist adds a distribution that provides modules (name => version) and
+// has the given runtime requirements.
+func (c *testCPAN) dist(path string, modules map[string]string, requires CPAN.Requirements) {
+	if c.index == nil {
+		c.index = make(IndexMap)
+		c.metas = make(map[string]*CPAN.Meta)
+	}
+	for m, v := range modules {
+		c.index[m] = &CPAN.PackagesIndexEntry{Package: m, Version: v, Path: path}
+	}
+	c.metas[path] = &CPAN.Meta{Prereqs: CPAN.Prereqs{"runtime": {"requires": requires}}}
+}
+
+func (c *testCPAN) meta(ctx context.Context, distPath string) (*CPAN.Meta, error) {
+	m, ok := c.metas[distPath]
+	if !ok {
+		return nil, fmt.Errorf("no such dist")
+	}
+	return m, nil
+}
+
+func (c *testCPAN) resolver() *Resolver {
+	return &Resolver{Index: c.index, Meta: c.meta}
+}
+
+func planDists(plan Plan) []string {
+	dists := make([]string, len(plan))
+	for i, s := range plan {
+		dists[i] = s.Dist
+	}
+	return dists
+}
+
+func TestResolve(t *testing.T) {
+	var c testCPAN
+	c.dist("A/AA/AAA/App-1.0.tar.gz", map[string]string{"App": "1.0"}, CPAN.Requirements{
+		"Moo":          "2",
+		"Scalar::Util": "1.50",
+		"perl":         "5.010",
+	})
+	c.dist("H/HA/HAARG/Moo-2.004.tar.gz", map[string]string{"Moo": "2.004", "Moo::Role": "2.004"}, CPAN.Requirements{
+		"Role::Tiny":   "2.001",
+		"Moo::Role":    "0",
+		"Scalar::Util": "0",
+	})
+	c.dist("H/HA/HAARG/Role-Tiny-2.002.tar.gz", map[string]string{"Role::Tiny": "2.002"}, nil)
+	c.dist("P/PE/PEVANS/Scalar-List-Utils-1.63.tar.gz", map[string]string{"Scalar::Util": "1.63", "List::Util": "1.63"}, nil)
+
+	r := c.resolver()
+	r.Perl = "5.036"
+	r.Core = func(module string, rng CPAN.VersionRange) bool {
+		// Scalar::Util 1.55 is core
+		return module == "Scalar::Util" && rng.AcceptsString("1.55")
+	}
+	plan, err := r.Resolve(context.Background(), CPAN.Requirements{"App": "0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"H/HA/HAARG/Role-Tiny-2.002.tar.gz",
+		"H/HA/HAARG/Moo-2.004.tar.gz",
+		"A/AA/AAA/App-1.0.tar.gz",
+	}
+	if got := planDists(plan); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %q", got)
+	}
+	if !reflect.DeepEqual(plan[1].Modules, map[string]string{"Moo": "2.004"}) {
+		t.Errorf("modules: %v", plan[1].Modules)
+	}
+
+	// Scalar::Util 1.60 is not core anymore
+	plan, err = r.Resolve(context.Background(), CPAN.Requirements{"App": "0", "Scalar::Util": "1.60"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := planDists(plan); len(got) != 4 || got[3] != "A/AA/AAA/App-1.0.tar.gz" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestResolveUnsatisfiable(t *testing.T) {
+	var c testCPAN
+	c.dist("A/AA/AAA/App-1.0.tar.gz", map[string]string{"App": "1.0"}, CPAN.Requirements{
+		"Lib":     ">= 1.0, < 2.0",
+		"Missing": "0",
+		"perl":    "5.020",
+	})
+	c.dist("L/LI/LIB/Lib-2.5.tar.gz", map[string]string{"Lib": "2.5"}, nil)
+	c.dist("O/OT/OTHER/Other-1.0.tar.gz", map[string]string{"Other": "1.0"}, nil)
+	c.metas["O/OT/OTHER/Other-1.0.tar.gz"].Prereqs["runtime"]["conflicts"] = CPAN.Requirements{"Lib": "< 3.0"}
+
+	r := c.resolver()
+	r.Perl = "5.010"
+	_, err := r.Resolve(context.Background(), CPAN.Requirements{"App": "0", "Lib": "1.5", "Other": "0"})
+	errs, ok := err.(UnsatisfiableErrors)
+	if !ok {
+		t.Fatalf("got %v", err)
+	}
+	msg := err.Error()
+	for _, expected := range []string{
+		"Lib: version 2.5 from L/LI/LIB/Lib-2.5.tar.gz does not satisfy the requirements; required by root (1.5), A/AA/AAA/App-1.0.tar.gz (>= 1.0, < 2.0)",
+		"Missing: not found in the index; required by A/AA/AAA/App-1.0.tar.gz (0)",
+		"perl: version 5.010 of perl does not satisfy the requirements; required by A/AA/AAA/App-1.0.tar.gz (5.020)",
+	} {
+		if !strings.Contains(msg, expected) {
+			t.Errorf("missing %q in:\n%s", expected, msg)
+		}
+	}
+	if len(errs) != 3 {
+		t.Errorf("got %d errors", len(errs))
+	}
+
+	// Conflict
+	_, err = r.Resolve(context.Background(), CPAN.Requirements{"Lib": "2", "Other": "0"})
+	if err == nil || !strings.Contains(err.Error(), "conflicts with O/OT/OTHER/Other-1.0.tar.gz (< 3.0)") {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestResolveCycle(t *testing.T) {
+	var c testCPAN
+	c.dist("A/AA/AAA/A-1.tar.gz", map[string]string{"A": "1"}, CPAN.Requirements{"B": "0"})
+	c.dist("B/BB/BBB/B-1.tar.gz", map[string]string{"B": "1"}, CPAN.Requirements{"C": "0"})
+	c.dist("C/CC/CCC/C-1.tar.gz", map[string]string{"C": "1"}, CPAN.Requirements{"A": "0"})
+
+	_, err := c.resolver().Resolve(context.Background(), CPAN.Requirements{"A": "0"})
+	cycle, ok := err.(*CycleError)
+	if !ok {
+		t.Fatalf("got %v", err)
+	}
+	if len(cycle.Dists) != 3 {
+		t.Errorf("got %s", err)
+	}
+}
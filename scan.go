@@ -0,0 +1,248 @@
+package CPAN
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// A private package hidden from PAUSE with a line break between
+	// "package" and the name does not match
+	rePackage = regexp.MustCompile(`^\s*package\s+([A-Za-z_][\w:']*)\s*(v?[\d._]+)?\s*[;{]`)
+	// Static forms, possibly after "use version;": $VERSION = '1.23',
+	// our $VERSION = "1.23", ($VERSION) = ..., $Foo::VERSION = ...,
+	// qv('1.2.3'), q{1.23}, version->declare('v1.2.3')
+	reVersion = regexp.MustCompile(`(?:^|;)\s*(?:our\s+)?\(?\s*\$((?:[\w:]+::)?)VERSION\s*\)?\s*=\s*(?:(?:qv|version->(?:declare|parse))\(\s*|qq?\s*[({]\s*)?['"]?(v?\d[\d._]*)`)
+)
+
+// noIndexDirs are the directories of a distribution that are never indexed.
+var noIndexDirs = []string{"t/", "xt/", "inc/", "local/", "perl5/", "fatlib/", "examples/", "eg/", "blib/"}
+
+// ScannedPackage is a package declaration found in a Perl source file.
+type ScannedPackage struct {
+	Package string
+	Version string
+	// Line is the line number of the first declaration.
+	Line int
+}
+
+// ScanPerlModule extracts the package declarations from the Perl source read
+// from r, with the version declared with each package, like the PAUSE
+// indexer does. Nothing is executed: only the static forms of the $VERSION
+// assignment are recognized.
+//
+// Declarations in POD and after __END__ or __DATA__ are ignored. Packages
+// hidden from PAUSE with a line break after the package keyword are
+// skipped, as are main and DB.
+func ScanPerlModule(r io.Reader) ([]ScannedPackage, error) {
+	var (
+		pkgs    []ScannedPackage
+		current = -1
+		inPOD   bool
+		lineNum int
+	)
+	find := func(name string) int {
+		for i := range pkgs {
+			if pkgs[i].Package == name {
+				return i
+			}
+		}
+		return -1
+	}
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1024*1024)
+	for s.Scan() {
+		lineNum++
+		line := s.Text()
+		if strings.HasPrefix(line, "=") && len(line) > 1 && isASCIILetter(line[1]) {
+			inPOD = !strings.HasPrefix(line, "=cut")
+			continue
+		}
+		if inPOD {
+			continue
+		}
+		if strings.HasPrefix(line, "__END__") || strings.HasPrefix(line, "__DATA__") {
+			break
+		}
+		if m := rePackage.FindStringSubmatch(line); m != nil {
+			name := strings.Replace(m[1], "'", "::", -1)
+			if name == "main" || name == "DB" {
+				current = -1
+				continue
+			}
+			current = find(name)
+			if current == -1 {
+				pkgs = append(pkgs, ScannedPackage{Package: name, Line: lineNum})
+				current = len(pkgs) - 1
+			}
+			if m[2] != "" {
+				pkgs[current].Version = m[2]
+			}
+			continue
+		}
+		if m := reVersion.FindStringSubmatch(line); m != nil {
+			target := current
+			if m[1] != "" {
+				// $Foo::Bar::VERSION applies to Foo::Bar only
+				target = find(strings.TrimSuffix(m[1], "::"))
+			}
+			if target >= 0 && pkgs[target].Version == "" {
+				pkgs[target].Version = m[2]
+			}
+		}
+	}
+	return pkgs, s.Err()
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// distRelPath strips the top-level directory of a path inside an archive.
+func distRelPath(name string) string {
+	name = strings.TrimPrefix(name, "./")
+	if i := strings.IndexByte(name, '/'); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// scannedFile is a candidate for the indexing of a package.
+type scannedFile struct {
+	ScannedPackage
+	File string
+}
+
+// better reports whether c should be preferred to o as the file that
+// provides the package, following PAUSE: first the file named after the
+// package, then the higher version, then the shallowest file.
+func (c *scannedFile) better(o *scannedFile) bool {
+	if cm, om := c.matchesName(), o.matchesName(); cm != om {
+		return cm
+	}
+	if cv, ov := c.Version != "", o.Version != ""; cv != ov {
+		return cv
+	}
+	if cmp := CompareVersions(c.Version, o.Version); cmp != 0 {
+		return cmp > 0
+	}
+	if cd, od := strings.Count(c.File, "/"), strings.Count(o.File, "/"); cd != od {
+		return cd < od
+	}
+	return c.File < o.File
+}
+
+// matchesName reports whether the file path ends with the path derived from
+// the package name: lib/Foo/Bar.pm for Foo::Bar.
+func (c *scannedFile) matchesName() bool {
+	p := "/" + strings.Replace(c.Package, "::", "/", -1) + ".pm"
+	return strings.HasSuffix("/"+c.File, p)
+}
+
+// IndexDist reads the distribution archive r and returns the packages it
+// provides, as entries of 02packages sorted by package name. distPath is the
+// path of the archive relative to authors/id, such as
+// "D/DO/DOLMEN/Git-Sub-0.163320.tar.gz".
+//
+// The provides section of META.json (or META.yml) is used if present.
+// Otherwise .pm files are scanned with ScanPerlModule. In both cases the
+// no_index section of META is honored, in addition to the directories
+// always ignored by PAUSE (t, xt, inc...). When a package is declared in
+// several files, the file named after the package wins, then the highest
+// version.
+func IndexDist(r io.Reader, distPath string) ([]*PackagesIndexEntry, error) {
+	var (
+		scanned  []*scannedFile
+		meta     *Meta
+		metaName string
+	)
+	err := WalkDist(r, distPath, func(f *DistFile) error {
+		rel := distRelPath(f.Name)
+		switch rel {
+		case "META.json", "META.yml":
+			// META.json has precedence
+			if meta != nil && metaName == "META.json" {
+				return nil
+			}
+			buf, err := ioutil.ReadAll(f)
+			if err != nil {
+				return err
+			}
+			if m, err := ParseMeta(buf); err == nil {
+				meta = m
+				metaName = rel
+			}
+			return nil
+		}
+		if path.Ext(rel) != ".pm" {
+			return nil
+		}
+		for _, dir := range noIndexDirs {
+			if strings.HasPrefix(rel, dir) {
+				return nil
+			}
+		}
+		pkgs, err := ScanPerlModule(f)
+		if err != nil {
+			return err
+		}
+		for _, p := range pkgs {
+			scanned = append(scanned, &scannedFile{ScannedPackage: p, File: rel})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var noIndex NoIndex
+	if meta != nil {
+		noIndex = meta.NoIndex
+	}
+	var entries []*PackagesIndexEntry
+	if meta != nil && len(meta.Provides) > 0 {
+		for pkg, p := range meta.Provides {
+			if noIndex.SkipsPackage(pkg) {
+				continue
+			}
+			entries = append(entries, &PackagesIndexEntry{
+				Package: pkg,
+				Version: p.Version,
+				Path:    distPath,
+			})
+		}
+	} else {
+		// The META file may come after the modules in the archive: no_index
+		// is applied once everything is scanned
+		candidates := make(map[string]*scannedFile)
+		for _, c := range scanned {
+			if noIndex.SkipsFile(c.File) || noIndex.SkipsPackage(c.Package) {
+				continue
+			}
+			if prev := candidates[c.Package]; prev == nil || c.better(prev) {
+				candidates[c.Package] = c
+			}
+		}
+		for pkg, c := range candidates {
+			entries = append(entries, &PackagesIndexEntry{
+				Package: pkg,
+				Version: c.Version,
+				Path:    distPath,
+			})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Package < entries[j].Package
+	})
+	for _, e := range entries {
+		if e.Version == "" {
+			e.Version = "undef"
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,135 @@
+package CPAN
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestScanPerlModule(t *testing.T) {
+	src := `package Foo;
+our $VERSION = '1.02';
+
+package # hide from PAUSE
+    Foo::_Private;
+our $VERSION = '9';
+
+package Foo::Block 0.5 {
+    1;
+}
+
+package Foo::Qualified;
+$Foo::Qualified::VERSION = "0.03";
+$Foo::VERSION = '7.0';
+
+package Foo::Qv;
+use version; our $VERSION = qv('1.2.3');
+
+package Foo::Declare;
+our $VERSION = version->declare("v2.0.1");
+
+package Foo::List;
+our ($VERSION) = '3.14';
+
+package Foo::Q;
+our $VERSION = q{0.001_002};
+
+package Foo::Dynamic;
+our $VERSION = $Foo::VERSION;
+
+package main;
+$VERSION = '0.1';
+
+=head1 SYNOPSIS
+
+  package Not::A::Package;
+
+=cut
+
+package Foo'Old;
+
+__END__
+package After::End;
+`
+	pkgs, err := ScanPerlModule(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]string)
+	for _, p := range pkgs {
+		got[p.Package] = p.Version
+	}
+	expected := map[string]string{
+		"Foo":            "1.02",
+		"Foo::Block":     "0.5",
+		"Foo::Qualified": "0.03",
+		"Foo::Qv":        "1.2.3",
+		"Foo::Declare":   "v2.0.1",
+		"Foo::List":      "3.14",
+		"Foo::Q":         "0.001_002",
+		"Foo::Dynamic":   "",
+		"Foo::Old":       "",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v\nexpected %v", got, expected)
+	}
+	if pkgs[0].Line != 1 || pkgs[1].Package != "Foo::Block" || pkgs[1].Line != 8 {
+		t.Errorf("lines: %+v", pkgs[:2])
+	}
+}
+
+func TestIndexDistMeta(t *testing.T) {
+	f, err := os.Open("testdata/Foo-Bar-1.02.tar.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	entries, err := IndexDist(f, "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.Package+" "+e.Version)
+	}
+	if expected := []string{"Foo::Bar 1.02", "Foo::Bar::Baz 0.5"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestIndexDistScan(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, f := range []struct{ name, content string }{
+		{"Foo-1.0/lib/Foo.pm", "package Foo;\nour $VERSION = '1.0';\npackage Foo::Bar;\n1;\n"},
+		{"Foo-1.0/lib/Foo/Bar.pm", "package Foo::Bar;\nour $VERSION = '0.5';\n1;\n"},
+		{"Foo-1.0/lib/Foo/Util.pm", "package Foo::Util;\nour $VERSION = '2';\npackage Foo::Util::Internal;\n"},
+		{"Foo-1.0/lib/Foo/Hidden.pm", "package Foo::Hidden;\n"},
+		{"Foo-1.0/t/lib/Test.pm", "package Test;\n"},
+		{"Foo-1.0/tools/Tool.pm", "package Tool;\n"},
+		{"Foo-1.0/META.yml", "name: Foo\nversion: 1.0\nno_index:\n  directory: [tools]\n  file: [lib/Foo/Hidden.pm]\n  namespace: [Foo::Util]\n"},
+	} {
+		tw.WriteHeader(&tar.Header{Name: f.name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(f.content))})
+		tw.Write([]byte(f.content))
+	}
+	tw.Close()
+	gz.Close()
+
+	entries, err := IndexDist(&buf, "D/DO/DOLMEN/Foo-1.0.tar.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.Package+" "+e.Version)
+	}
+	// Foo::Bar is from lib/Foo/Bar.pm despite the lower version
+	if expected := []string{"Foo 1.0", "Foo::Bar 0.5", "Foo::Util 2"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,227 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+// distInfo caches the packages of a distribution file.
+type distInfo struct {
+	modTime time.Time
+	size    int64
+	entries []*CPAN.PackagesIndexEntry
+}
+
+// fileSum caches the checksums of a file.
+type fileSum struct {
+	modTime time.Time
+	size    int64
+	sum     CPAN.CheckSum
+}
+
+func fingerprint(h hash.Hash, p string, fi os.FileInfo) {
+	fmt.Fprintf(h, "%s\x00%d\x00%d\n", p, fi.ModTime().UnixNano(), fi.Size())
+}
+
+// packagesIndex generates 02packages from the distributions of the tree.
+// The result is served for IndexTTL, then the tree is scanned again and
+// the content regenerated if a distribution was added, changed or removed.
+func (h *Handler) packagesIndex() (*generated, error) {
+	root := filepath.Join(h.Root, "authors", "id")
+	if _, err := os.Stat(root); err != nil {
+		return nil, err
+	}
+
+	h.indexMu.Lock()
+	defer h.indexMu.Unlock()
+
+	ttl := h.IndexTTL
+	if ttl == 0 {
+		ttl = DefaultIndexTTL
+	}
+	now := time.Now()
+	if h.index != nil && now.Sub(h.indexTime) < ttl {
+		return h.index, nil
+	}
+
+	if h.dists == nil {
+		h.dists = make(map[string]*distInfo)
+	}
+
+	fp := sha256.New()
+	var modTime time.Time
+	seen := make(map[string]bool)
+	err := filepath.Walk(root, func(name string, fi os.FileInfo, err error) error {
+		if err != nil || fi.IsDir() || !CPAN.IsDistArchive(name) {
+			return err
+		}
+		rel, err := filepath.Rel(root, name)
+		if err != nil {
+			return err
+		}
+		distPath := filepath.ToSlash(rel)
+		seen[distPath] = true
+		fingerprint(fp, distPath, fi)
+		if fi.ModTime().After(modTime) {
+			modTime = fi.ModTime()
+		}
+
+		if d := h.dists[distPath]; d != nil && d.modTime.Equal(fi.ModTime()) && d.size == fi.Size() {
+			return nil
+		}
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		entries, err := CPAN.IndexDist(f, distPath)
+		if err != nil {
+			// Broken archive: skip it, as PAUSE would
+			entries = nil
+		}
+		h.dists[distPath] = &distInfo{modTime: fi.ModTime(), size: fi.Size(), entries: entries}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for distPath := range h.dists {
+		if !seen[distPath] {
+			delete(h.dists, distPath)
+		}
+	}
+
+	g := &generated{fingerprint: hex.EncodeToString(fp.Sum(nil)[:16]), modTime: modTime}
+	if h.index != nil && h.index.fingerprint == g.fingerprint {
+		h.indexTime = now
+		return h.index, nil
+	}
+
+	// For each package keep the highest version, or the latest upload.
+	// Like PAUSE, developer releases are not indexed.
+	latest := make(map[string]*CPAN.PackagesIndexEntry)
+	latestTime := make(map[string]time.Time)
+	for distPath, d := range h.dists {
+		for _, e := range d.entries {
+			if !CPAN.ExcludeDevReleases(e) {
+				continue
+			}
+			if prev := latest[e.Package]; prev != nil {
+				c := CPAN.CompareVersions(e.Version, prev.Version)
+				if c == 0 {
+					if t := latestTime[e.Package]; d.modTime.Equal(t) {
+						c = strings.Compare(distPath, prev.Path)
+					} else if d.modTime.After(t) {
+						c = 1
+					}
+				}
+				if c <= 0 {
+					continue
+				}
+			}
+			latest[e.Package] = e
+			latestTime[e.Package] = d.modTime
+		}
+	}
+	entries := make([]*CPAN.PackagesIndexEntry, 0, len(latest))
+	for _, e := range latest {
+		entries = append(entries, e)
+	}
+
+	var buf bytes.Buffer
+	header := map[string][]string{
+		"Last-Updated": {modTime.UTC().Format(time.RFC1123)},
+	}
+	if err = CPAN.WritePackagesIndex(&buf, header, entries); err != nil {
+		return nil, err
+	}
+	g.content = buf.Bytes()
+	h.index = g
+	h.indexTime = now
+	return g, nil
+}
+
+// checkSums generates the CHECKSUMS of the directory dir (relative to Root).
+func (h *Handler) checkSums(dir string) (*generated, error) {
+	localDir := filepath.Join(h.Root, filepath.FromSlash(dir))
+	files, err := ioutil.ReadDir(localDir)
+	if err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.checksums == nil {
+		h.checksums = make(map[string]*fileSum)
+	}
+
+	fp := sha256.New()
+	var modTime time.Time
+	sums := make(map[string]CPAN.CheckSum, len(files))
+	for _, fi := range files {
+		name := fi.Name()
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+		p := dir + "/" + name
+		fingerprint(fp, p, fi)
+		if fi.ModTime().After(modTime) {
+			modTime = fi.ModTime()
+		}
+		if fi.IsDir() {
+			sums[name] = CPAN.CheckSum{IsDir: 1}
+			continue
+		}
+		if !fi.Mode().IsRegular() {
+			continue
+		}
+
+		if s := h.checksums[p]; s != nil && s.modTime.Equal(fi.ModTime()) && s.size == fi.Size() {
+			sums[name] = s.sum
+			continue
+		}
+		f, err := os.Open(filepath.Join(localDir, name))
+		if err != nil {
+			return nil, err
+		}
+		sum, err := CPAN.ComputeCheckSum(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		sum.MTime = fi.ModTime().UTC().Format("2006-01-02")
+		h.checksums[p] = &fileSum{modTime: fi.ModTime(), size: fi.Size(), sum: sum}
+		sums[name] = sum
+	}
+
+	g := &generated{
+		modTime:     modTime,
+		fingerprint: hex.EncodeToString(fp.Sum(nil)[:16]),
+	}
+	// Reuse the previous content to keep it stable (it includes a date)
+	if prev := h.dirSums[dir]; prev != nil && prev.fingerprint == g.fingerprint {
+		return prev, nil
+	}
+
+	var buf bytes.Buffer
+	if err = CPAN.WriteCheckSums(&buf, sums); err != nil {
+		return nil, err
+	}
+	g.content = buf.Bytes()
+	if h.dirSums == nil {
+		h.dirSums = make(map[string]*generated)
+	}
+	h.dirSums[dir] = g
+	return g, nil
+}
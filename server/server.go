@@ -0,0 +1,148 @@
+// Package server serves a local directory laid out as a CPAN mirror over
+// HTTP, for clients such as cpanm or cpm.
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+// Cache-Control values.
+const (
+	// Distribution files never change once uploaded
+	cacheDist = "public, max-age=86400"
+	// Index files must be revalidated
+	cacheIndex = "no-cache"
+)
+
+// Handler serves the CPAN tree rooted at Root.
+//
+// Range and conditional requests are supported.
+type Handler struct {
+	// Root is the local directory, with the authors/id and modules
+	// subdirectories.
+	Root string
+	// GenerateIndex enables the generation of 02packages.details.txt.gz
+	// from the distributions found under authors/id, if the file does not
+	// exist.
+	GenerateIndex bool
+	// GenerateCheckSums enables the generation of the CHECKSUMS of the
+	// directories under authors/id that do not have one. Generated
+	// CHECKSUMS are not signed.
+	GenerateCheckSums bool
+	// IndexTTL is how long a generated 02packages is served before the
+	// tree is scanned again for changes. DefaultIndexTTL is used if zero; a
+	// negative value scans the tree on each request.
+	IndexTTL time.Duration
+
+	mu        sync.Mutex
+	checksums map[string]*fileSum
+	dirSums   map[string]*generated
+
+	indexMu   sync.Mutex
+	dists     map[string]*distInfo
+	index     *generated
+	indexTime time.Time
+}
+
+// DefaultIndexTTL is the default value of Handler.IndexTTL.
+const DefaultIndexTTL = time.Minute
+
+// Invalidate drops the generated 02packages: the next request scans the
+// tree, without waiting for IndexTTL. Call it after adding or removing
+// distributions.
+func (h *Handler) Invalidate() {
+	h.indexMu.Lock()
+	h.indexTime = time.Time{}
+	h.indexMu.Unlock()
+}
+
+// generated is the content of a generated file.
+type generated struct {
+	content     []byte
+	modTime     time.Time
+	fingerprint string
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	p := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+	local := filepath.Join(h.Root, filepath.FromSlash(p))
+
+	f, err := os.Open(local)
+	if err != nil {
+		if os.IsNotExist(err) {
+			if h.serveGenerated(w, r, p) {
+				return
+			}
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	if fi.IsDir() {
+		http.FileServer(http.Dir(h.Root)).ServeHTTP(w, r)
+		return
+	}
+
+	w.Header().Set("Cache-Control", cacheControl(p))
+	w.Header().Set("ETag", fmt.Sprintf(`"%x-%x"`, fi.ModTime().UnixNano(), fi.Size()))
+	http.ServeContent(w, r, fi.Name(), fi.ModTime(), f)
+}
+
+func cacheControl(p string) string {
+	if strings.HasPrefix(p, "authors/id/") && path.Base(p) != "CHECKSUMS" {
+		return cacheDist
+	}
+	return cacheIndex
+}
+
+// serveGenerated serves the generated content for p, if enabled.
+func (h *Handler) serveGenerated(w http.ResponseWriter, r *http.Request, p string) bool {
+	var (
+		g   *generated
+		err error
+	)
+	switch {
+	case h.GenerateIndex && p == CPAN.PackagesIndexPath:
+		g, err = h.packagesIndex()
+	case h.GenerateCheckSums && strings.HasPrefix(p, "authors/id/") && path.Base(p) == "CHECKSUMS":
+		g, err = h.checkSums(path.Dir(p))
+	default:
+		return false
+	}
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false
+		}
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return true
+	}
+
+	w.Header().Set("Cache-Control", cacheIndex)
+	w.Header().Set("ETag", `"`+g.fingerprint+`"`)
+	http.ServeContent(w, r, path.Base(p), g.modTime, bytes.NewReader(g.content))
+	return true
+}
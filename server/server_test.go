@@ -0,0 +1,156 @@
+package server
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dolmen-go/CPAN"
+)
+
+func TestHandler(t *testing.T) {
+	root, err := ioutil.TempDir("", "cpan-serve-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	tarball, err := ioutil.ReadFile("../testdata/Foo-Bar-1.02.tar.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(root, "authors", "id", "D", "DO", "DOLMEN")
+	os.MkdirAll(dir, 0777)
+	if err = ioutil.WriteFile(filepath.Join(dir, "Foo-Bar-1.02.tar.gz"), tarball, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	h := &Handler{
+		Root:              root,
+		GenerateIndex:     true,
+		GenerateCheckSums: true,
+	}
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	get := func(p string, h http.Header) *http.Response {
+		req, _ := http.NewRequest("GET", srv.URL+"/"+p, nil)
+		for k, v := range h {
+			req.Header[k] = v
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	resp := get(CPAN.PackagesIndexPath, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("02packages: %s", resp.Status)
+	}
+	if cc := resp.Header.Get("Cache-Control"); cc != cacheIndex {
+		t.Errorf("02packages: Cache-Control: %q", cc)
+	}
+	etag := resp.Header.Get("ETag")
+	_, entries, done := CPAN.ReadPackagesIndex(resp.Body)
+	packages := make(map[string]string)
+	for e := range entries {
+		if e.Path != "D/DO/DOLMEN/Foo-Bar-1.02.tar.gz" {
+			t.Errorf("%s: unexpected path %s", e.Package, e.Path)
+		}
+		packages[e.Package] = e.Version
+	}
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if packages["Foo::Bar"] != "1.02" || packages["Foo::Bar::Baz"] != "0.5" {
+		t.Errorf("02packages: got %v", packages)
+	}
+
+	resp = get(CPAN.PackagesIndexPath, http.Header{"If-None-Match": {etag}})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("02packages revalidation: %s", resp.Status)
+	}
+
+	// A new distribution is only seen after IndexTTL, or Invalidate
+	other := filepath.Join(root, "authors", "id", "O", "OT", "OTHER")
+	os.MkdirAll(other, 0777)
+	if err = ioutil.WriteFile(filepath.Join(other, "Foo-Bar-1.02.tar.gz"), tarball, 0644); err != nil {
+		t.Fatal(err)
+	}
+	resp = get(CPAN.PackagesIndexPath, nil)
+	resp.Body.Close()
+	if resp.Header.Get("ETag") != etag {
+		t.Error("02packages: regenerated before IndexTTL")
+	}
+	h.Invalidate()
+	resp = get(CPAN.PackagesIndexPath, nil)
+	resp.Body.Close()
+	if resp.Header.Get("ETag") == etag {
+		t.Error("02packages: not regenerated after Invalidate")
+	}
+
+	// A TRIAL upload, the latest, is not indexed
+	trial := filepath.Join(other, "Foo-Bar-1.03-TRIAL.tar.gz")
+	if err = ioutil.WriteFile(trial, tarball, 0644); err != nil {
+		t.Fatal(err)
+	}
+	future := time.Now().Add(time.Hour)
+	os.Chtimes(trial, future, future)
+	h.Invalidate()
+	resp = get(CPAN.PackagesIndexPath, nil)
+	_, entries, done = CPAN.ReadPackagesIndex(resp.Body)
+	n := 0
+	for e := range entries {
+		n++
+		if strings.Contains(e.Path, "TRIAL") {
+			t.Errorf("%s: indexed from %s", e.Package, e.Path)
+		}
+	}
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if n != len(packages) {
+		t.Errorf("02packages: got %d entries", n)
+	}
+
+	resp = get("authors/id/D/DO/DOLMEN/CHECKSUMS", nil)
+	sums, err := CPAN.ReadUnsignedCheckSums(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, ok := sums["Foo-Bar-1.02.tar.gz"]
+	if !ok {
+		t.Fatalf("CHECKSUMS: %+v", sums)
+	}
+	if err = sum.Verify(bytes.NewReader(tarball)); err != nil {
+		t.Error(err)
+	}
+
+	resp = get("authors/id/D/DO/DOLMEN/Foo-Bar-1.02.tar.gz", http.Header{"Range": {"bytes=0-9"}})
+	got, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusPartialContent || !bytes.Equal(got, tarball[:10]) {
+		t.Errorf("range request: %s %q", resp.Status, got)
+	}
+	if cc := resp.Header.Get("Cache-Control"); cc != cacheDist {
+		t.Errorf("dist: Cache-Control: %q", cc)
+	}
+
+	resp = get("authors/id/D/DO/NOBODY/CHECKSUMS", nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("missing directory: %s", resp.Status)
+	}
+}
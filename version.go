@@ -0,0 +1,264 @@
+package CPAN
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Version is a Perl module version normalized as a list of integer
+// components, like version.pm does: the decimal version "1.0203" is
+// (1, 20, 300) and the dotted version "v1.2.3" is (1, 2, 3).
+type Version []int
+
+// ErrInvalidVersion is returned by ParseVersion for strings that do not
+// start with a number.
+var ErrInvalidVersion = errors.New("invalid version")
+
+// ParseVersion parses a Perl version string. Both decimal ("1.23") and
+// dotted-decimal ("v1.2.3", "1.2.3") forms are supported. Underscores of
+// developer versions are ignored. "undef" and the empty string are version 0.
+func ParseVersion(s string) (Version, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "undef" {
+		return Version{0}, nil
+	}
+	s = strings.Replace(s, "_", "", -1)
+	if s == "" {
+		return nil, ErrInvalidVersion
+	}
+
+	dotted := false
+	if s[0] == 'v' {
+		dotted = true
+		s = s[1:]
+	} else if strings.Count(s, ".") >= 2 {
+		dotted = true
+	}
+
+	// Lax parsing: ignore trailing garbage
+	end := 0
+	for end < len(s) && (s[end] == '.' || (s[end] >= '0' && s[end] <= '9')) {
+		end++
+	}
+	s = strings.TrimRight(s[:end], ".")
+	if s == "" || s[0] == '.' && dotted {
+		return nil, ErrInvalidVersion
+	}
+
+	if dotted {
+		parts := strings.Split(s, ".")
+		v := make(Version, len(parts))
+		for i, p := range parts {
+			n, err := strconv.Atoi(p)
+			if err != nil {
+				return nil, ErrInvalidVersion
+			}
+			v[i] = n
+		}
+		return v, nil
+	}
+
+	var intPart, frac string
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, frac = s[:i], s[i+1:]
+	} else {
+		intPart = s
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+	n, err := strconv.Atoi(intPart)
+	if err != nil {
+		return nil, ErrInvalidVersion
+	}
+	v := Version{n}
+	for len(frac) > 0 {
+		group := frac
+		if len(group) > 3 {
+			group = group[:3]
+		}
+		frac = frac[len(group):]
+		group = (group + "00")[:3]
+		n, _ = strconv.Atoi(group)
+		v = append(v, n)
+	}
+	return v, nil
+}
+
+// reLaxVersion is the LAX_VERSION regexp of version.pm: a decimal version
+// ("1.23", "1.", ".5") or a dotted-decimal version ("v1.2", "1.2.3"), with
+// an optional developer part ("_01").
+var reLaxVersion = regexp.MustCompile(`^(?:v[0-9]+(?:\.[0-9]+)*(?:_[0-9]+)?|[0-9]+(?:\.[0-9]+){2,}(?:_[0-9]+)?|[0-9]+(?:\.[0-9]*)?(?:_[0-9]+)?|\.[0-9]+(?:_[0-9]+)?)$`)
+
+// IsLaxVersion reports whether s is a version accepted by version.pm, and
+// by CPAN::Meta::Spec. Unlike ParseVersion, trailing garbage such as in
+// "1.0foo" is rejected.
+func IsLaxVersion(s string) bool {
+	return reLaxVersion.MatchString(s)
+}
+
+// Cmp compares v and w and returns -1, 0 or +1.
+// Missing trailing components are zeros.
+func (v Version) Cmp(w Version) int {
+	n := len(v)
+	if len(w) > n {
+		n = len(w)
+	}
+	for i := 0; i < n; i++ {
+		var a, b int
+		if i < len(v) {
+			a = v[i]
+		}
+		if i < len(w) {
+			b = w[i]
+		}
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	}
+	return 0
+}
+
+// String returns the normalized dotted-decimal form, such as "v1.20.300".
+func (v Version) String() string {
+	var b strings.Builder
+	b.WriteByte('v')
+	for i, n := range v {
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(strconv.Itoa(n))
+	}
+	for i := len(v); i < 3; i++ {
+		b.WriteString(".0")
+	}
+	return b.String()
+}
+
+// CompareVersions compares two Perl version strings and returns -1, 0 or +1.
+// Invalid versions are lower than any valid one.
+func CompareVersions(a, b string) int {
+	va, errA := ParseVersion(a)
+	vb, errB := ParseVersion(b)
+	switch {
+	case errA != nil && errB != nil:
+		return strings.Compare(a, b)
+	case errA != nil:
+		return -1
+	case errB != nil:
+		return 1
+	}
+	return va.Cmp(vb)
+}
+
+// IsDevVersion reports whether s is a developer release version (with an
+// underscore, or "-TRIAL").
+func IsDevVersion(s string) bool {
+	return strings.Contains(s, "_") || strings.Contains(s, "-TRIAL")
+}
+
+// VersionConstraint is one constraint of a VersionRange.
+type VersionConstraint struct {
+	// Op is one of ">=", "<=", ">", "<", "==" and "!=".
+	Op      string
+	Version string
+
+	v Version
+}
+
+// VersionRange is a version range of CPAN::Meta::Spec, such as
+// ">= 1.2, != 1.5, < 2.0". All the constraints must be satisfied.
+// The empty VersionRange accepts any version.
+type VersionRange []VersionConstraint
+
+// ParseVersionRange parses a version range. A bare version "1.2" means
+// ">= 1.2", and "0" (or the empty string) means any version.
+func ParseVersionRange(s string) (VersionRange, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	parts := strings.Split(s, ",")
+	r := make(VersionRange, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		op := ">="
+		for _, o := range []string{">=", "<=", "==", "!=", ">", "<"} {
+			if strings.HasPrefix(part, o) {
+				op = o
+				part = strings.TrimSpace(part[len(o):])
+				break
+			}
+		}
+		if part == "" {
+			return nil, fmt.Errorf("invalid version range %q", s)
+		}
+		v, err := ParseVersion(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version range %q: %s", s, err)
+		}
+		if op == ">=" && v.Cmp(Version{0}) == 0 {
+			// ">= 0" accepts anything
+			continue
+		}
+		r = append(r, VersionConstraint{Op: op, Version: part, v: v})
+	}
+	return r, nil
+}
+
+// Accepts reports whether v satisfies all the constraints of r.
+func (r VersionRange) Accepts(v Version) bool {
+	for _, c := range r {
+		cmp := v.Cmp(c.v)
+		var ok bool
+		switch c.Op {
+		case ">=":
+			ok = cmp >= 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case "<":
+			ok = cmp < 0
+		case "==":
+			ok = cmp == 0
+		case "!=":
+			ok = cmp != 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// AcceptsString is like Accepts for an unparsed version. Invalid versions
+// are not accepted, except by the empty range.
+func (r VersionRange) AcceptsString(version string) bool {
+	if len(r) == 0 {
+		return true
+	}
+	v, err := ParseVersion(version)
+	return err == nil && r.Accepts(v)
+}
+
+// String returns the range in the CPAN::Meta::Spec syntax.
+func (r VersionRange) String() string {
+	if len(r) == 0 {
+		return "0"
+	}
+	if len(r) == 1 && r[0].Op == ">=" {
+		return r[0].Version
+	}
+	parts := make([]string, len(r))
+	for i, c := range r {
+		parts[i] = c.Op + " " + c.Version
+	}
+	return strings.Join(parts, ", ")
+}
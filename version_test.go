@@ -0,0 +1,77 @@
+package CPAN
+
+import (
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	for _, test := range []struct {
+		in  string
+		out string
+	}{
+		{"1.23", "v1.230.0"},
+		{"1.0203", "v1.20.300"},
+		{"0.163320", "v0.163.320"},
+		{"v1.2.3", "v1.2.3"},
+		{"1.2.3", "v1.2.3"},
+		{"v5.30", "v5.30.0"},
+		{"1.23_01", "v1.230.100"},
+		{"undef", "v0.0.0"},
+		{"", "v0.0.0"},
+		{"2", "v2.0.0"},
+		{".5", "v0.500.0"},
+		{"1.02a", "v1.20.0"},
+	} {
+		v, err := ParseVersion(test.in)
+		if err != nil {
+			t.Errorf("%q: %s", test.in, err)
+			continue
+		}
+		if got := v.String(); got != test.out {
+			t.Errorf("%q: got %s, expected %s", test.in, got, test.out)
+		}
+	}
+
+	for _, in := range []string{"abc", "v", "v.1", "_", "__", " _ "} {
+		if _, err := ParseVersion(in); err == nil {
+			t.Errorf("%q: error expected", in)
+		}
+	}
+}
+
+func TestIsLaxVersion(t *testing.T) {
+	for _, in := range []string{"1.23", "1.", ".5", "1.23_01", "v1.2.3", "v5", "1.2.3", "1.2.3_4", "0"} {
+		if !IsLaxVersion(in) {
+			t.Errorf("%q: rejected", in)
+		}
+	}
+	for _, in := range []string{"", "undef", "1.0foo", "1.02a", "v", "v.1", "1..2", "1.2-TRIAL", " 1.2"} {
+		if IsLaxVersion(in) {
+			t.Errorf("%q: accepted", in)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	for _, test := range []struct {
+		a, b string
+		cmp  int
+	}{
+		{"1.10", "1.9", -1},
+		{"1.10", "1.1", 0},
+		{"1.10", "1.100", 0},
+		{"v1.2.3", "1.002003", 0},
+		{"1.2.3", "1.2.10", -1},
+		{"0.163320", "0.163130", 1},
+		{"undef", "0.01", -1},
+		{"1.50", "1.5", 0},
+		{"junk", "0", -1},
+	} {
+		if got := CompareVersions(test.a, test.b); got != test.cmp {
+			t.Errorf("CompareVersions(%q, %q): got %d, expected %d", test.a, test.b, got, test.cmp)
+		}
+		if got := CompareVersions(test.b, test.a); got != -test.cmp {
+			t.Errorf("CompareVersions(%q, %q): got %d, expected %d", test.b, test.a, got, -test.cmp)
+		}
+	}
+}